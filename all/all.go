@@ -18,6 +18,7 @@ import (
 	_ "github.com/nsa-yoda/namegen/plugins/arabic"
 	_ "github.com/nsa-yoda/namegen/plugins/aramaic"
 	_ "github.com/nsa-yoda/namegen/plugins/baltic"
+	_ "github.com/nsa-yoda/namegen/plugins/bengali"
 	_ "github.com/nsa-yoda/namegen/plugins/celtic"
 	_ "github.com/nsa-yoda/namegen/plugins/chinese"
 	_ "github.com/nsa-yoda/namegen/plugins/english"
@@ -36,16 +37,22 @@ import (
 	_ "github.com/nsa-yoda/namegen/plugins/kazakh"
 	_ "github.com/nsa-yoda/namegen/plugins/korean"
 	_ "github.com/nsa-yoda/namegen/plugins/malay"
+	_ "github.com/nsa-yoda/namegen/plugins/malayalam"
 	_ "github.com/nsa-yoda/namegen/plugins/maori"
+	_ "github.com/nsa-yoda/namegen/plugins/marathi"
 	_ "github.com/nsa-yoda/namegen/plugins/nahuatl"
 	_ "github.com/nsa-yoda/namegen/plugins/nordic"
 	_ "github.com/nsa-yoda/namegen/plugins/portuguese"
+	_ "github.com/nsa-yoda/namegen/plugins/punjabi"
 	_ "github.com/nsa-yoda/namegen/plugins/samoan"
 	_ "github.com/nsa-yoda/namegen/plugins/slavic"
 	_ "github.com/nsa-yoda/namegen/plugins/spanish"
 	_ "github.com/nsa-yoda/namegen/plugins/swahili"
+	_ "github.com/nsa-yoda/namegen/plugins/tahitian"
 	_ "github.com/nsa-yoda/namegen/plugins/tamil"
+	_ "github.com/nsa-yoda/namegen/plugins/telugu"
 	_ "github.com/nsa-yoda/namegen/plugins/thai"
+	_ "github.com/nsa-yoda/namegen/plugins/tongan"
 	_ "github.com/nsa-yoda/namegen/plugins/turkish"
 	_ "github.com/nsa-yoda/namegen/plugins/uzbek"
 	_ "github.com/nsa-yoda/namegen/plugins/vietnamese"
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// AddressUse says which part of the name a title is used with.
+type AddressUse int
+
+const (
+	UseSurname AddressUse = iota // "Mr. Smith", "Tanaka-san" (falls back to the given name)
+	UseGiven                     // "Don Pedro", "Ahmet Bey", "Khun Somchai"
+	UseFull                      // "Chief Emeka Okafor", "Kim Minjun-ssi"
+)
+
+// Honorific is a title or generational suffix a profile can attach to a name.
+// An After title with an empty Join is bound to the word it follows (Nahuatl
+// "-tzin"); with UseGiven it stays on the given name in the formal name too.
+// A bound title is not repeated on a word that already ends in it.
+type Honorific struct {
+	Text   string
+	Gender string     // "male", "female", "neutral", or "" for any gender
+	Weight int        // relative frequency; 0 counts as 1
+	Use    AddressUse // titles only
+	After  bool       // titles only: follows the name instead of preceding it
+	Join   string     // titles only, with After: separator before Text ("-" for "-san", " " for " Bey")
+
+	// Replaces lists lowercase word endings a bound title takes the place
+	// of, longest first: Nahuatl "tzin" replaces "-tl" ("Xochitl" ->
+	// "Xochitzin").
+	Replaces []string
+}
+
+// Forms lists the honorifics and generational suffixes of a culture.
+type Forms struct {
+	Titles   []Honorific
+	Suffixes []Honorific // need a surname; Use/After/Join are ignored
+
+	// SuffixPct is the share of names (with a surname and an agreeing
+	// suffix) that get a suffix when ProfileConfig.Suffixes is set.
+	SuffixPct int
+}
+
+// Addressed is implemented by profiles that know their forms of address.
+type Addressed interface {
+	Forms() Forms
+}
+
+// addForms fills Title, Suffix, Address and Formal on res according to
+// cfg.Titles and cfg.Suffixes. It draws from its own seed derived from
+// res.Seed, so the name itself is the same with or without titles.
+func addForms(p NameProfile, cfg ProfileConfig, res NameResult) NameResult {
+	ap, ok := p.(Addressed)
+	if !ok {
+		return res
+	}
+	forms := ap.Forms()
+	r := rand.New(rand.NewSource(DeriveSeed(res.Seed, "forms")))
+
+	var title Honorific
+	if cfg.Titles {
+		title, ok = pickHonorific(r, forms.Titles, cfg.Gender)
+		if ok {
+			res.Title = title.Text
+			res.Address = render(title, res.First, res.Last, false, false)
+		}
+	}
+	if cfg.Suffixes && res.Last != "" && Chance(r, forms.SuffixPct) {
+		if s, ok := pickHonorific(r, forms.Suffixes, cfg.Gender); ok {
+			res.Suffix = s.Text
+		}
+	}
+
+	// The formal name keeps the traditional order when the profile gives one.
+	first, last := res.First, res.Last
+	if res.Parts != nil && res.Parts.Full != "" && !cfg.Reverse {
+		first, last = res.Parts.Full, ""
+	} else if m := MiddleName(res); m != "" && cfg.Reverse {
+		first = m + " " + first // "Phan" + "Thị Phương"
+	}
+	full := render(Honorific{}, first, last, true, cfg.Reverse)
+	if res.Title != "" {
+		full = render(title, first, last, true, cfg.Reverse)
+	}
+	if res.Suffix != "" {
+		full += " " + res.Suffix
+	}
+	res.Formal = full
+	return res
+}
+
+// render attaches t to the name. With formal set the whole name is used
+// (surname first with reverse), otherwise only the part t.Use asks for.
+func render(t Honorific, first, last string, formal, reverse bool) string {
+	if formal && t.After && t.Join == "" && t.Use == UseGiven {
+		first, t.Text = bind(first, t), ""
+	}
+	name := strings.TrimSpace(first + " " + last)
+	if reverse {
+		name = strings.TrimSpace(last + " " + first)
+	}
+	if t.Text == "" {
+		return name
+	}
+	if !formal {
+		switch {
+		case t.Use == UseGiven || last == "":
+			name = first
+		case t.Use == UseSurname:
+			name = last
+		}
+	}
+	if t.After && t.Join == "" {
+		return bind(name, t)
+	}
+	if t.After {
+		return name + t.Join + t.Text
+	}
+	return t.Text + " " + name
+}
+
+// bind attaches the bound title t to word, unless word already ends in it
+// ("Yolotzin"). An ending listed in t.Replaces is dropped first, and a word
+// ending in the title's start shares it ("Metztli" -> "Metz" -> "Metzin").
+func bind(word string, t Honorific) string {
+	lower := strings.ToLower(word)
+	if strings.HasSuffix(lower, strings.ToLower(t.Text)) {
+		return word
+	}
+	for _, e := range t.Replaces {
+		if strings.HasSuffix(lower, e) {
+			word = word[:len(word)-len(e)]
+			break
+		}
+	}
+	for n := min(len(word), len(t.Text)); n > 0; n-- {
+		if strings.EqualFold(word[len(word)-n:], t.Text[:n]) {
+			return word + t.Text[n:]
+		}
+	}
+	return word + t.Text
+}
+
+// pickHonorific draws a weighted honorific agreeing with gender: entries
+// with a Gender only match that gender ("" counts as "neutral"), so a
+// neutral config only gets gender-neutral forms.
+func pickHonorific(r RandLike, list []Honorific, gender string) (Honorific, bool) {
+	if gender != "male" && gender != "female" {
+		gender = "neutral"
+	}
+	total := 0
+	for _, h := range list {
+		if h.Gender == "" || h.Gender == gender {
+			total += max(h.Weight, 1)
+		}
+	}
+	if total == 0 {
+		return Honorific{}, false
+	}
+	n := r.Intn(total)
+	for _, h := range list {
+		if h.Gender != "" && h.Gender != gender {
+			continue
+		}
+		if n -= max(h.Weight, 1); n < 0 {
+			return h, true
+		}
+	}
+	return Honorific{}, false
+}
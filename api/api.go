@@ -53,27 +53,67 @@ func ListProfiles() []string {
 
 // ProfileConfig holds runtime options the main binary passes to the plugin.
 type ProfileConfig struct {
-	Count       int    `json:"count,omitempty"`
-	Mode        string `json:"mode,omitempty"`
-	Seed        int64  `json:"seed,omitempty"`        // 0 for random
-	Realism     int    `json:"realism,omitempty"`     // 0..100
-	Gender      string `json:"gender,omitempty"`      // "male", "female", "neutral"
-	Family      string `json:"family,omitempty"`      // optional family override like "japan", "nordic", etc.
-	IncludeLast bool   `json:"includeLast,omitempty"` // -l flag
-	Reverse     bool   `json:"reverse,omitempty"`     // -r flag
-	DevMode     bool   `json:"devMode,omitempty"`
+	Count        int    `json:"count,omitempty"`
+	Mode         string `json:"mode,omitempty"`
+	Seed         int64  `json:"seed,omitempty"`         // 0 for random
+	Realism      int    `json:"realism,omitempty"`      // 0..100
+	Gender       string `json:"gender,omitempty"`       // "male", "female", "neutral"
+	Family       string `json:"family,omitempty"`       // optional family override / sub-locale like "japan", "polish", "lt"
+	Convention   string `json:"convention,omitempty"`   // naming convention within a profile, e.g. "patronymic"
+	Compound     int    `json:"compound,omitempty"`     // % chance of compound given names ("Jose Luis"); 0 profile default, <0 never
+	Depth        int    `json:"depth,omitempty"`        // ancestors named in chain names (nasab); 0 profile default
+	Script       string `json:"script,omitempty"`       // ScriptLatin (default), ScriptNative or a script the profile lists (Scripted); fills NameResult.Native
+	Romanization string `json:"romanization,omitempty"` // romanization system for profiles implementing Romanizer, e.g. "wade-giles"
+	ASCII        bool   `json:"ascii,omitempty"`        // fold names to plain ASCII: no diacritics, tone marks or ʻokina
+	IncludeLast  bool   `json:"includeLast,omitempty"`  // -l flag
+	Reverse      bool   `json:"reverse,omitempty"`      // -r flag
+	DevMode      bool   `json:"devMode,omitempty"`
+	Unique       bool   `json:"unique,omitempty"`    // skip names already produced by the same Batch/Stream
+	Titles       bool   `json:"titles,omitempty"`    // add an honorific/title (profiles implementing Addressed)
+	Suffixes     bool   `json:"suffixes,omitempty"`  // sometimes add a generational suffix such as "Jr." or "Filho"
+	Nicknames    bool   `json:"nicknames,omitempty"` // fill NameResult.Nicknames (see api.Nicknames)
+
+	// Constraints restricts which names are accepted; enforced by api.Generate.
+	Constraints Constraints `json:"constraints,omitempty"`
+
+	// Filters reject unwanted names (profanity, blocklists, famous people);
+	// rejected names are regenerated by api.Generate.
+	Filters []Filter `json:"-"`
 }
 
 // NameResult is returned by plugin when asked to generate a name.
 type NameResult struct {
-	First string
-	Last  string // may be empty if plugin doesn't generate surnames
+	First string `json:"first"`
+	Last  string `json:"last,omitempty"` // may be empty if plugin doesn't generate surnames
+
+	// Seed is the seed that produced this name, set by api.Generate.
+	// Generating again with it (and the same config) reproduces the name.
+	// It is 0 for a name grafted to meet constraints, which no seed
+	// reproduces on its own; replay the run seed instead.
+	Seed int64 `json:"seed,omitempty"`
+
+	// Forms of address, set by api.Generate with cfg.Titles / cfg.Suffixes.
+	Title   string `json:"title,omitempty"`   // "Dr.", "Doña", "san"
+	Suffix  string `json:"suffix,omitempty"`  // "Jr.", "III", "Filho"
+	Address string `json:"address,omitempty"` // how to address them: "Mr. Smith", "Tanaka-san", "Don Pedro"
+	Formal  string `json:"formal,omitempty"`  // full name with title and suffix: "Dr. Jane Smith Jr."
+
+	// Parts is the structured name, for profiles that build names from parts.
+	Parts *NameParts `json:"parts,omitempty"`
+
+	// Native is the name in the culture's own script and order ("李明"),
+	// set with cfg.Script ScriptNative by profiles that have one.
+	Native string `json:"native,omitempty"`
+
+	// Nicknames are informal variants of First, set with cfg.Nicknames.
+	Nicknames []string `json:"nicknames,omitempty"`
 }
 
 // NameProfile is the interface plugin must expose as a symbol (e.g. "Profile").
 // Plugins should export a variable named "Profile" of this type.
 type NameProfile interface {
 	// Generate returns a NameResult obeying the provided ProfileConfig.
+	// It must be safe for concurrent use (api.Batch calls it from workers).
 	Generate(cfg ProfileConfig) (NameResult, error)
 
 	// Info returns human-readable metadata: supported family keys, language name, notes.
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// batchChunk is the number of consecutive names a worker generates per job.
+const batchChunk = 256
+
+// NameSeed returns the seed of the i-th name in a run seeded with master.
+// Every name has its own seed, so results do not depend on how names are
+// spread across workers. Name 0 uses master unchanged, which keeps
+// single-name runs identical to calling the profile directly.
+func NameSeed(master int64, i int) int64 {
+	if i == 0 {
+		return master
+	}
+	return childSeed(master, streamNames, uint64(i))
+}
+
+// Batch generates cfg.Count names (at least one) on workers goroutines and
+// calls emit for each, in index order, from the calling goroutine. Candidate
+// k is generated through Generate with NameSeed(cfg.Seed, k), so the output
+// is deterministic and identical for any worker count. With cfg.Unique,
+// duplicate candidates are skipped and generation continues until Count
+// unique names were emitted; the result matches Stream for the same config.
+// A zero cfg.Seed is resolved to one random master seed for the whole batch.
+//
+// workers <= 0 means runtime.GOMAXPROCS(0). Batch stops at the first
+// generation or emit error, or when ctx is cancelled.
+func Batch(ctx context.Context, p NameProfile, cfg ProfileConfig, workers int, emit func(i int, res NameResult) error) error {
+	n := cfg.Count
+	if n <= 0 {
+		n = 1
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	master := ResolveSeed(cfg.Seed)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type chunk struct {
+		idx   int
+		names []NameResult
+		err   error
+	}
+
+	// Without Unique exactly n candidates are needed; with it, the feeder
+	// keeps going until the emitter has n unique names and cancels.
+	chunks := (n + batchChunk - 1) / batchChunk
+	limit := n
+	var seen *dedupe
+	if cfg.Unique {
+		chunks, limit = -1, -1
+		seen = newDedupe()
+	}
+	jobs := make(chan int)
+	done := make(chan chunk, workers)
+	// window bounds the chunks generated but not yet emitted, so a slow
+	// writer cannot make the batch buffer everything in memory.
+	window := make(chan struct{}, 2*workers)
+
+	go func() {
+		defer close(jobs)
+		for c := 0; chunks < 0 || c < chunks; c++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- c:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				lo, hi := c*batchChunk, (c+1)*batchChunk
+				if limit >= 0 {
+					hi = min(hi, limit)
+				}
+				out := chunk{idx: c, names: make([]NameResult, 0, hi-lo)}
+				wcfg := cfg
+				for i := lo; i < hi; i++ {
+					wcfg.Seed = NameSeed(master, i)
+					res, err := Generate(p, wcfg)
+					if err != nil {
+						out.err = fmt.Errorf("name %d: %w", i, err)
+						break
+					}
+					out.names = append(out.names, res)
+				}
+				select {
+				case done <- out:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	pending := map[int]chunk{}
+	next, emitted := 0, 0
+	for c := range done {
+		pending[c.idx] = c
+		for {
+			ready, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			if ready.err != nil {
+				return ready.err
+			}
+			for _, res := range ready.names {
+				if seen != nil {
+					ok, err := seen.admit(res)
+					if err != nil {
+						return err
+					}
+					if !ok {
+						continue
+					}
+				}
+				if err := emit(emitted, res); err != nil {
+					return err
+				}
+				if emitted++; emitted == n {
+					return nil
+				}
+			}
+			<-window
+			next++
+		}
+	}
+	return ctx.Err()
+}
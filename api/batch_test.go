@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/english"
+	"github.com/nsa-yoda/namegen/plugins/japanese"
+	"github.com/nsa-yoda/namegen/plugins/spanish"
+)
+
+func benchConfig() api.ProfileConfig {
+	return api.ProfileConfig{Mode: english.PROFILE, Seed: 42, Realism: 70, IncludeLast: true}
+}
+
+// TestBatchMatchesStream checks that Batch gives the same names, in the same
+// order, on one worker and on eight, and that Stream yields them too, with
+// Unique and a filter set. The count spans several batch chunks.
+func TestBatchMatchesStream(t *testing.T) {
+	noK := api.FilterFunc(func(res api.NameResult) (string, bool) {
+		return "contains k", strings.ContainsAny(res.First, "Kk")
+	})
+	profiles := map[string]api.NameProfile{
+		english.PROFILE:  english.Profile,
+		spanish.PROFILE:  spanish.Profile,
+		japanese.PROFILE: japanese.Profile,
+	}
+	for mode, p := range profiles {
+		t.Run(mode, func(t *testing.T) {
+			cfg := api.ProfileConfig{
+				Mode: mode, Seed: 7, Count: 600, Realism: 50, IncludeLast: true, Unique: true,
+				Filters: []api.Filter{api.ProfanityFilter(), noK},
+			}
+			batch := func(workers int) []api.NameResult {
+				var out []api.NameResult
+				err := api.Batch(context.Background(), p, cfg, workers, func(i int, res api.NameResult) error {
+					if i != len(out) {
+						t.Fatalf("workers=%d: emitted index %d after %d names", workers, i, len(out))
+					}
+					out = append(out, res)
+					return nil
+				})
+				if err != nil {
+					t.Fatalf("workers=%d: %v", workers, err)
+				}
+				return out
+			}
+			one, eight := batch(1), batch(8)
+			var stream []api.NameResult
+			for res, err := range api.Stream(context.Background(), p, cfg) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				stream = append(stream, res)
+			}
+
+			if len(one) != cfg.Count || len(eight) != cfg.Count || len(stream) != cfg.Count {
+				t.Fatalf("got %d, %d and %d names, want %d", len(one), len(eight), len(stream), cfg.Count)
+			}
+			seen := map[string]bool{}
+			for i := range one {
+				if !reflect.DeepEqual(one[i], eight[i]) || !reflect.DeepEqual(one[i], stream[i]) {
+					t.Fatalf("name %d: workers=1 %+v, workers=8 %+v, stream %+v", i, one[i], eight[i], stream[i])
+				}
+				key := one[i].First + " " + one[i].Last
+				if seen[key] {
+					t.Errorf("name %d: %q repeated with Unique", i, key)
+				}
+				seen[key] = true
+				if strings.ContainsAny(one[i].First, "Kk") {
+					t.Errorf("name %d: %q passed the filter", i, one[i].First)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	cfg := benchConfig()
+	for i := 0; b.Loop(); i++ {
+		cfg.Seed = api.NameSeed(42, i)
+		if _, err := api.Generate(english.Profile, cfg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerateFiltered(b *testing.B) {
+	cfg := benchConfig()
+	cfg.Filters = []api.Filter{api.ProfanityFilter(), api.FamousNamesFilter()}
+	for i := 0; b.Loop(); i++ {
+		cfg.Seed = api.NameSeed(42, i)
+		if _, err := api.Generate(english.Profile, cfg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkBatch generates 10,000 names per iteration on one worker and on
+// GOMAXPROCS workers (at least two); ns/op divided by 10,000 is the cost per
+// name.
+func BenchmarkBatch(b *testing.B) {
+	const names = 10000
+	for _, workers := range []int{1, max(runtime.GOMAXPROCS(0), 2)} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			cfg := benchConfig()
+			cfg.Count = names
+			emit := func(int, api.NameResult) error { return nil }
+			for b.Loop() {
+				if err := api.Batch(context.Background(), english.Profile, cfg, workers, emit); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultMaxAttempts bounds rejection sampling when Constraints.MaxAttempts is 0.
+const DefaultMaxAttempts = 1000
+
+var ErrUnsatisfiable = errors.New("constraints cannot be satisfied")
+
+// Constraints describes what a generated name must look like.
+// Length, prefix, suffix, regex and syllable rules apply to the first name;
+// Initials applies to the first and (when present) last name.
+// Prefix, Suffix and Initials compare case-insensitively, and the Contains /
+// Excludes regular expressions are compiled case-insensitive as well.
+type Constraints struct {
+	MinLen      int    `json:"minLen,omitempty"`      // minimum letters, 0 for no limit
+	MaxLen      int    `json:"maxLen,omitempty"`      // maximum letters, 0 for no limit
+	Prefix      string `json:"prefix,omitempty"`      // e.g. "K"
+	Suffix      string `json:"suffix,omitempty"`      // e.g. "ia"
+	Contains    string `json:"contains,omitempty"`    // regex the name must match
+	Excludes    string `json:"excludes,omitempty"`    // regex the name must not match
+	Syllables   int    `json:"syllables,omitempty"`   // exact syllable count, 0 for any
+	Initials    string `json:"initials,omitempty"`    // e.g. "A.B." (first + last)
+	MaxAttempts int    `json:"maxAttempts,omitempty"` // rejection sampling budget
+}
+
+// IsZero reports whether no constraint is set.
+func (c Constraints) IsZero() bool {
+	return c == Constraints{}
+}
+
+// compiledConstraints is a validated Constraints ready for matching.
+type compiledConstraints struct {
+	Constraints
+	contains     *regexp.Regexp
+	excludes     *regexp.Regexp
+	firstInitial rune
+	lastInitial  rune
+}
+
+// compileConstraints validates c against cfg and reports obviously
+// unsatisfiable combinations before any generation is attempted.
+func compileConstraints(c Constraints, cfg ProfileConfig) (*compiledConstraints, error) {
+	cc := &compiledConstraints{Constraints: c}
+
+	if c.MinLen < 0 || c.MaxLen < 0 || c.Syllables < 0 || c.MaxAttempts < 0 {
+		return nil, fmt.Errorf("%w: negative limits are not allowed", ErrUnsatisfiable)
+	}
+	if c.MaxLen > 0 && c.MinLen > c.MaxLen {
+		return nil, fmt.Errorf("%w: minLen %d is greater than maxLen %d", ErrUnsatisfiable, c.MinLen, c.MaxLen)
+	}
+	if c.Prefix != "" && c.Suffix != "" {
+		lp, ls := utf8.RuneCountInString(c.Prefix), utf8.RuneCountInString(c.Suffix)
+		if c.MaxLen > 0 && lp+ls > c.MaxLen && !overlaps(c.Prefix, c.Suffix, c.MaxLen) {
+			return nil, fmt.Errorf("%w: prefix %q and suffix %q do not fit in maxLen %d", ErrUnsatisfiable, c.Prefix, c.Suffix, c.MaxLen)
+		}
+	}
+	for _, affix := range []string{c.Prefix, c.Suffix} {
+		if c.MaxLen > 0 && utf8.RuneCountInString(affix) > c.MaxLen {
+			return nil, fmt.Errorf("%w: %q is longer than maxLen %d", ErrUnsatisfiable, affix, c.MaxLen)
+		}
+	}
+
+	var err error
+	if c.Contains != "" {
+		if cc.contains, err = regexp.Compile("(?i)" + c.Contains); err != nil {
+			return nil, fmt.Errorf("%w: bad contains pattern: %v", ErrUnsatisfiable, err)
+		}
+	}
+	if c.Excludes != "" {
+		if cc.excludes, err = regexp.Compile("(?i)" + c.Excludes); err != nil {
+			return nil, fmt.Errorf("%w: bad excludes pattern: %v", ErrUnsatisfiable, err)
+		}
+		for _, affix := range []string{c.Prefix, c.Suffix} {
+			if affix != "" && cc.excludes.MatchString(affix) {
+				return nil, fmt.Errorf("%w: excludes pattern rejects %q", ErrUnsatisfiable, affix)
+			}
+		}
+	}
+
+	if c.Initials != "" {
+		var letters []rune
+		for _, ch := range c.Initials {
+			if unicode.IsLetter(ch) {
+				letters = append(letters, unicode.ToUpper(ch))
+			}
+		}
+		switch len(letters) {
+		case 1:
+			cc.firstInitial = letters[0]
+		case 2:
+			if !cfg.IncludeLast {
+				return nil, fmt.Errorf("%w: initials %q need a last name", ErrUnsatisfiable, c.Initials)
+			}
+			cc.firstInitial, cc.lastInitial = letters[0], letters[1]
+		default:
+			return nil, fmt.Errorf("%w: initials %q must be one or two letters", ErrUnsatisfiable, c.Initials)
+		}
+		if c.Prefix != "" {
+			p, _ := utf8.DecodeRuneInString(c.Prefix)
+			if unicode.ToUpper(p) != cc.firstInitial {
+				return nil, fmt.Errorf("%w: prefix %q conflicts with initials %q", ErrUnsatisfiable, c.Prefix, c.Initials)
+			}
+		}
+	}
+
+	if c.Syllables > 0 && c.MaxLen > 0 && c.Syllables > c.MaxLen {
+		return nil, fmt.Errorf("%w: %d syllables cannot fit in maxLen %d", ErrUnsatisfiable, c.Syllables, c.MaxLen)
+	}
+
+	return cc, nil
+}
+
+// overlaps reports whether prefix and suffix can share letters inside maxLen
+// (e.g. prefix "Ka", suffix "ai" fit in "Kai").
+func overlaps(prefix, suffix string, maxLen int) bool {
+	p, s := []rune(strings.ToLower(prefix)), []rune(strings.ToLower(suffix))
+	for k := min(len(p), len(s)); k > 0; k-- {
+		if string(p[len(p)-k:]) == string(s[:k]) && len(p)+len(s)-k <= maxLen {
+			return true
+		}
+	}
+	return false
+}
+
+// Match reports whether res satisfies every constraint.
+func (cc *compiledConstraints) Match(res NameResult) bool {
+	name := res.First
+	n := letterCount(name)
+	if cc.MinLen > 0 && n < cc.MinLen {
+		return false
+	}
+	if cc.MaxLen > 0 && n > cc.MaxLen {
+		return false
+	}
+	lower := strings.ToLower(name)
+	if cc.Prefix != "" && !strings.HasPrefix(lower, strings.ToLower(cc.Prefix)) {
+		return false
+	}
+	if cc.Suffix != "" && !strings.HasSuffix(lower, strings.ToLower(cc.Suffix)) {
+		return false
+	}
+	if cc.contains != nil && !cc.contains.MatchString(name) {
+		return false
+	}
+	if cc.excludes != nil && cc.excludes.MatchString(name) {
+		return false
+	}
+	if cc.Syllables > 0 && CountSyllables(name) != cc.Syllables {
+		return false
+	}
+	if cc.firstInitial != 0 && initialOf(res.First) != cc.firstInitial {
+		return false
+	}
+	if cc.lastInitial != 0 && initialOf(res.Last) != cc.lastInitial {
+		return false
+	}
+	return true
+}
+
+// Target rewrites res toward the prefix/suffix/initials constraints by
+// grafting them onto the generated stem. It returns false when there is
+// nothing to graft.
+func (cc *compiledConstraints) Target(res NameResult) (NameResult, bool) {
+	changed := false
+	prefix := cc.Prefix
+	if prefix == "" && cc.firstInitial != 0 {
+		prefix = string(cc.firstInitial)
+	}
+	if prefix != "" && !strings.HasPrefix(strings.ToLower(res.First), strings.ToLower(prefix)) {
+		res.First = graftPrefix(prefix, res.First)
+		changed = true
+	}
+	if cc.Suffix != "" && !strings.HasSuffix(strings.ToLower(res.First), strings.ToLower(cc.Suffix)) {
+		res.First = graftSuffix(res.First, cc.Suffix)
+		changed = true
+	}
+	if cc.lastInitial != 0 && res.Last != "" && initialOf(res.Last) != cc.lastInitial {
+		res.Last = graftPrefix(string(cc.lastInitial), res.Last)
+		changed = true
+	}
+	return res, changed
+}
+
+// graftPrefix replaces the leading consonants (or, for a vowel-final prefix,
+// the leading syllable onset and nucleus) of name with prefix.
+func graftPrefix(prefix, name string) string {
+	rs := []rune(strings.ToLower(name))
+	pr := []rune(prefix)
+	i := 0
+	for i < len(rs) && !isVowel(rs[i]) {
+		i++
+	}
+	if isVowel(pr[len(pr)-1]) {
+		for i < len(rs) && isVowel(rs[i]) {
+			i++
+		}
+	}
+	out := string(pr) + string(rs[i:])
+	return titleFirst(out)
+}
+
+// graftSuffix replaces the trailing syllable coda (or, for a vowel-initial
+// suffix, the trailing vowel group as well) of name with suffix.
+func graftSuffix(name, suffix string) string {
+	rs := []rune(name)
+	sr := []rune(strings.ToLower(suffix))
+	i := len(rs)
+	for i > 0 && !isVowel(unicode.ToLower(rs[i-1])) {
+		i--
+	}
+	if isVowel(sr[0]) {
+		for i > 0 && isVowel(unicode.ToLower(rs[i-1])) {
+			i--
+		}
+	}
+	return titleFirst(string(rs[:i]) + string(sr))
+}
+
+// CountSyllables approximates the syllable count of a romanized name by
+// counting vowel groups. A trailing silent "e" after a consonant is ignored
+// for names of more than one group (e.g. "Blake").
+func CountSyllables(name string) int {
+	rs := []rune(strings.ToLower(name))
+	count := 0
+	inVowel := false
+	for _, ch := range rs {
+		if isVowel(ch) {
+			if !inVowel {
+				count++
+			}
+			inVowel = true
+		} else {
+			inVowel = false
+		}
+	}
+	if n := len(rs); count > 1 && n > 2 && rs[n-1] == 'e' && !isVowel(rs[n-2]) && rs[n-2] != 'l' {
+		count--
+	}
+	return count
+}
+
+// isVowel reports a Latin vowel letter, with or without diacritics (ö, ı,
+// ư, ế).
+func isVowel(ch rune) bool {
+	switch ch {
+	case 'æ', 'ø', 'ı', 'ə':
+		return true
+	}
+	if ch >= utf8.RuneSelf {
+		// A marked vowel decomposes to its base letter first.
+		ch, _ = utf8.DecodeRuneInString(norm.NFD.String(string(ch)))
+	}
+	switch ch {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+func letterCount(s string) int {
+	n := 0
+	for _, ch := range s {
+		if unicode.IsLetter(ch) {
+			n++
+		}
+	}
+	return n
+}
+
+func initialOf(s string) rune {
+	for _, ch := range s {
+		if unicode.IsLetter(ch) {
+			return unicode.ToUpper(ch)
+		}
+	}
+	return 0
+}
+
+func titleFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompileConstraints(t *testing.T) {
+	withLast := ProfileConfig{IncludeLast: true}
+	tests := []struct {
+		name string
+		c    Constraints
+		cfg  ProfileConfig
+		ok   bool
+	}{
+		{"zero", Constraints{}, ProfileConfig{}, true},
+		{"negative minLen", Constraints{MinLen: -1}, ProfileConfig{}, false},
+		{"negative attempts", Constraints{MaxAttempts: -5}, ProfileConfig{}, false},
+		{"minLen over maxLen", Constraints{MinLen: 8, MaxLen: 4}, ProfileConfig{}, false},
+		{"affixes too long", Constraints{Prefix: "Mar", Suffix: "ina", MaxLen: 5}, ProfileConfig{}, false},
+		{"affixes overlap", Constraints{Prefix: "Ka", Suffix: "ai", MaxLen: 3}, ProfileConfig{}, true},
+		{"prefix over maxLen", Constraints{Prefix: "Alexandra", MaxLen: 6}, ProfileConfig{}, false},
+		{"bad contains", Constraints{Contains: "(ab"}, ProfileConfig{}, false},
+		{"bad excludes", Constraints{Excludes: "[z"}, ProfileConfig{}, false},
+		{"excludes prefix", Constraints{Prefix: "Jo", Excludes: "^j"}, ProfileConfig{}, false},
+		{"excludes other", Constraints{Prefix: "Jo", Excludes: "x"}, ProfileConfig{}, true},
+		{"two initials without last", Constraints{Initials: "A.B."}, ProfileConfig{}, false},
+		{"two initials", Constraints{Initials: "A.B."}, withLast, true},
+		{"three initials", Constraints{Initials: "A.B.C."}, withLast, false},
+		{"no initials", Constraints{Initials: ".."}, withLast, false},
+		{"prefix against initial", Constraints{Prefix: "Ma", Initials: "J"}, ProfileConfig{}, false},
+		{"prefix with initial", Constraints{Prefix: "ja", Initials: "J"}, ProfileConfig{}, true},
+		{"syllables over maxLen", Constraints{Syllables: 5, MaxLen: 4}, ProfileConfig{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compileConstraints(tt.c, tt.cfg)
+			switch {
+			case tt.ok && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case !tt.ok && !errors.Is(err, ErrUnsatisfiable):
+				t.Errorf("got %v, want ErrUnsatisfiable", err)
+			}
+		})
+	}
+}
+
+func TestConstraintsMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Constraints
+		res  NameResult
+		want bool
+	}{
+		{"no constraints", Constraints{}, NameResult{First: "Anna"}, true},
+		{"minLen", Constraints{MinLen: 5}, NameResult{First: "Anna"}, false},
+		{"maxLen counts letters", Constraints{MaxLen: 5}, NameResult{First: "Jean-Luc"}, false},
+		{"maxLen with accents", Constraints{MaxLen: 4}, NameResult{First: "Zoë"}, true},
+		{"prefix ignores case", Constraints{Prefix: "ma"}, NameResult{First: "Marta"}, true},
+		{"prefix", Constraints{Prefix: "Ma"}, NameResult{First: "Anna"}, false},
+		{"suffix", Constraints{Suffix: "IA"}, NameResult{First: "Sofia"}, true},
+		{"contains", Constraints{Contains: "r{2}"}, NameResult{First: "Serra"}, true},
+		{"contains missing", Constraints{Contains: "r{2}"}, NameResult{First: "Sera"}, false},
+		{"excludes", Constraints{Excludes: "q"}, NameResult{First: "Quinn"}, false},
+		{"syllables", Constraints{Syllables: 2}, NameResult{First: "Marta"}, true},
+		{"wrong syllables", Constraints{Syllables: 3}, NameResult{First: "Marta"}, false},
+		{"first initial", Constraints{Initials: "m"}, NameResult{First: "Marta", Last: "Lopez"}, true},
+		{"both initials", Constraints{Initials: "M.L."}, NameResult{First: "Marta", Last: "Lopez"}, true},
+		{"wrong last initial", Constraints{Initials: "M.L."}, NameResult{First: "Marta", Last: "Garcia"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc, err := compileConstraints(tt.c, ProfileConfig{IncludeLast: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cc.Match(tt.res); got != tt.want {
+				t.Errorf("Match(%q %q) = %v, want %v", tt.res.First, tt.res.Last, got, tt.want)
+			}
+		})
+	}
+}
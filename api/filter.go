@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrFiltered = errors.New("every candidate was rejected by filters")
+
+// Filter is a pluggable post-generation check. api.Generate regenerates
+// (deterministically, from derived seeds) any name a filter rejects.
+// Batch calls filters from several goroutines, so they must be safe for
+// concurrent use.
+type Filter interface {
+	// Reject returns a reason and true when res must not be returned.
+	Reject(res NameResult) (reason string, rejected bool)
+}
+
+// FilterFunc adapts a plain function to the Filter interface.
+type FilterFunc func(res NameResult) (string, bool)
+
+func (f FilterFunc) Reject(res NameResult) (string, bool) { return f(res) }
+
+// wordFilter rejects names containing any substring term, or any whole-word
+// term as a complete name token. Substrings are looked for in each token,
+// or with joined in the tokens run together (a blocked "John Smith").
+type wordFilter struct {
+	kind       string
+	substrings []string
+	words      map[string]bool
+	joined     bool
+}
+
+func (f wordFilter) Reject(res NameResult) (string, bool) {
+	tokens := nameTokens(res)
+	if len(tokens) == 0 {
+		return "", false
+	}
+	forms := tokens
+	if f.joined {
+		forms = []string{strings.Join(tokens, "")}
+	}
+	for _, t := range forms {
+		for _, form := range []string{t, squeeze(t)} {
+			for _, s := range f.substrings {
+				if strings.Contains(form, s) {
+					return fmt.Sprintf("%s: contains %q", f.kind, s), true
+				}
+			}
+		}
+	}
+	for _, t := range tokens {
+		if f.words[t] || f.words[squeeze(t)] {
+			return fmt.Sprintf("%s: word %q", f.kind, t), true
+		}
+	}
+	return "", false
+}
+
+// ProfanityFilter returns a filter backed by the built-in multilingual
+// profanity list. Matching is case-insensitive, ignores diacritics and
+// common letter substitutions, and looks at each name token on its own:
+// first and last names are not run together, as that rejects real names
+// ("Azlan Aziz").
+func ProfanityFilter() Filter {
+	words := make(map[string]bool, len(profanityWords))
+	for _, w := range profanityWords {
+		words[w] = true
+	}
+	return wordFilter{kind: "profanity", substrings: profanitySubstrings, words: words}
+}
+
+// BlocklistFilter rejects names containing any of the given entries.
+// Entries are normalized like names and matched as substrings, so
+// "John Smith" blocks exactly that person and "grim" blocks "Grimwood".
+func BlocklistFilter(entries []string) Filter {
+	var subs []string
+	for _, e := range entries {
+		if n := strings.Join(strings.Fields(normalizeName(e)), ""); n != "" {
+			subs = append(subs, n)
+		}
+	}
+	return wordFilter{kind: "blocklist", substrings: subs, joined: true}
+}
+
+// LoadBlocklist reads a blocklist file: one entry per line, blank lines and
+// lines starting with '#' are ignored.
+func LoadBlocklist(path string) (Filter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return BlocklistFilter(entries), nil
+}
+
+// famousFilter rejects full names matching a well-known real person, in
+// either name order.
+type famousFilter map[string]bool
+
+func (f famousFilter) Reject(res NameResult) (string, bool) {
+	if res.First == "" || res.Last == "" {
+		return "", false
+	}
+	first, last := normalizeName(res.First), normalizeName(res.Last)
+	if f[first+" "+last] || f[last+" "+first] {
+		return fmt.Sprintf("famous: %s %s", res.First, res.Last), true
+	}
+	return "", false
+}
+
+// FamousNamesFilter rejects first+last combinations that collide with the
+// built-in list of famous real people. Names without a surname pass.
+func FamousNamesFilter() Filter {
+	f := make(famousFilter, len(famousNames))
+	for _, n := range famousNames {
+		f[strings.Join(strings.Fields(normalizeName(n)), " ")] = true
+	}
+	return f
+}
+
+// rejectedBy returns the first rejection reason among filters.
+func rejectedBy(filters []Filter, res NameResult) (string, bool) {
+	for _, f := range filters {
+		if f == nil {
+			continue
+		}
+		if reason, ok := f.Reject(res); ok {
+			return reason, true
+		}
+	}
+	return "", false
+}
+
+var leet = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s")
+
+// normalizeName lowercases s, strips diacritics and undoes common letter
+// substitutions. Separators become single spaces. It is stateless and safe
+// for concurrent use.
+func normalizeName(s string) string {
+	folded := leet.Replace(strings.ToLower(norm.NFD.String(s)))
+	var b strings.Builder
+	space := false
+	for _, ch := range folded {
+		if unicode.Is(unicode.Mn, ch) {
+			continue
+		}
+		if unicode.IsLetter(ch) {
+			b.WriteRune(ch)
+			space = false
+		} else if !space && b.Len() > 0 {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func nameTokens(res NameResult) []string {
+	return strings.Fields(normalizeName(res.First + " " + res.Last))
+}
+
+// squeeze collapses runs of the same letter ("fuuuck" -> "fuck").
+func squeeze(s string) string {
+	var b strings.Builder
+	var prev rune
+	for i, ch := range s {
+		if i == 0 || ch != prev {
+			b.WriteRune(ch)
+		}
+		prev = ch
+	}
+	return b.String()
+}
@@ -0,0 +1,134 @@
+package api
+
+import "fmt"
+
+// Generate asks p for a name and enforces cfg.Constraints and cfg.Filters.
+//
+// A zero cfg.Seed is first resolved to a random seed, so the result's Seed
+// can always replay it. Without constraints or filters Generate is then just
+// p.Generate(cfg). Otherwise it runs bounded rejection sampling: every
+// attempt re-seeds the profile with a seed derived from cfg.Seed, so a
+// seeded run stays reproducible and rejected names are replaced
+// deterministically. In the second half of the attempt budget it also tries
+// grafting the requested prefix/suffix/initials onto the generated stem
+// (targeted generation). A grafted name is not the output of any seed, so
+// its Seed is 0: replaying the attempt seed would give the stem.
+//
+// With cfg.ASCII every name is folded to plain ASCII (FoldASCII) before the
+// constraints see it.
+//
+// With cfg.Titles or cfg.Suffixes the accepted name then gets its forms of
+// address (see Addressed), and with cfg.Nicknames its nicknames, folded
+// too with cfg.ASCII. Nicknames go through cfg.Filters (with the surname)
+// and rejected ones are dropped.
+func Generate(p NameProfile, cfg ProfileConfig) (NameResult, error) {
+	res, grafted, err := generate(p, cfg)
+	if err != nil {
+		return res, err
+	}
+	if cfg.Titles || cfg.Suffixes {
+		res = addForms(p, cfg, res)
+	}
+	if cfg.Nicknames {
+		res.Nicknames = filterNicknames(cfg.Filters, res.Last, Nicknames(p, res.First, cfg.Gender))
+	}
+	if cfg.ASCII {
+		res = foldForms(res)
+	}
+	if grafted {
+		res.Seed = 0
+	}
+	return res, nil
+}
+
+// filterNicknames drops the nicknames any filter rejects alongside last.
+func filterNicknames(filters []Filter, last string, nicks []string) []string {
+	if len(filters) == 0 {
+		return nicks
+	}
+	kept := nicks[:0]
+	for _, n := range nicks {
+		if _, ok := rejectedBy(filters, NameResult{First: n, Last: last}); !ok {
+			kept = append(kept, n)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
+
+// profileGenerate is p.Generate with cfg.ASCII applied.
+func profileGenerate(p NameProfile, cfg ProfileConfig) (NameResult, error) {
+	res, err := p.Generate(cfg)
+	if err == nil && cfg.ASCII {
+		res = foldResult(res)
+	}
+	return res, err
+}
+
+// generate runs the rejection sampling. The result's Seed is the attempt
+// seed, which the forms of address are still derived from; grafted reports
+// a name from targeted generation.
+func generate(p NameProfile, cfg ProfileConfig) (res NameResult, grafted bool, err error) {
+	cfg.Seed = ResolveSeed(cfg.Seed)
+	if cfg.Constraints.IsZero() && len(cfg.Filters) == 0 {
+		res, err := profileGenerate(p, cfg)
+		res.Seed = cfg.Seed
+		return res, false, err
+	}
+
+	var cc *compiledConstraints
+	if !cfg.Constraints.IsZero() {
+		var err error
+		if cc, err = compileConstraints(cfg.Constraints, cfg); err != nil {
+			return NameResult{}, false, err
+		}
+	}
+
+	budget := cfg.Constraints.MaxAttempts
+	if budget <= 0 {
+		budget = DefaultMaxAttempts
+	}
+
+	// filtered remembers the last filter rejection so an exhausted budget
+	// can say why.
+	filtered := ""
+	accept := func(res NameResult) bool {
+		if cc != nil && !cc.Match(res) {
+			return false
+		}
+		if reason, ok := rejectedBy(cfg.Filters, res); ok {
+			filtered = reason
+			return false
+		}
+		return true
+	}
+
+	attemptCfg := cfg
+	for i := 0; i < budget; i++ {
+		attemptCfg.Seed = cfg.Seed
+		if i > 0 {
+			attemptCfg.Seed = childSeed(cfg.Seed, streamAttempts, uint64(i))
+		}
+
+		res, err := profileGenerate(p, attemptCfg)
+		if err != nil {
+			return NameResult{}, false, err
+		}
+		res.Seed = attemptCfg.Seed
+		if accept(res) {
+			return res, false, nil
+		}
+		if cc != nil && i >= budget/2 {
+			if t, ok := cc.Target(res); ok && accept(t) {
+				return t, true, nil
+			}
+		}
+	}
+
+	if filtered != "" {
+		return NameResult{}, false, fmt.Errorf("%w after %d attempts (last: %s)", ErrFiltered, budget, filtered)
+	}
+	return NameResult{}, false, fmt.Errorf("%w: no match after %d attempts", ErrUnsatisfiable, budget)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AlgorithmVersion changes whenever the same seed and config can produce
+// different names (profile data, seed derivation, pipeline order). It is
+// recorded in replay tokens.
+//
+//   - 2: Slavic and Baltic surname forms, Iberian compound names, the
+//     Mandarin syllable table and romanizations
+//   - 3: Korean, Japanese, Amharic, Indian, Polynesian, Vietnamese, Turkic,
+//     Hebrew and Aramaic names reworked
+//   - 4: profanity filter matches each name token on its own
+//   - 5: bound titles are not repeated and replace listed endings (Nahuatl
+//     "-tzin")
+//   - 6: nicknames go through the filters; more whole-word profanity
+//   - 7: toned pinyin keeps the syllable apostrophe (Xī'ān)
+//   - 8: an Amharic or Tigrinya father's name differs from the child's
+//   - 9: Vietnamese middle names in reversed names, identities and family
+//     trees
+//   - 10: gendered nickname endings; short names are no longer clipped
+//   - 11: Bengali, Telugu, Marathi, Punjabi and Malayalam procedural names
+//     use their own onsets and endings
+//   - 12: Uzbek and Kazakh surnames and patronymics follow the gender of a
+//     curated name drawn for a neutral request
+//   - 13: Vietnamese procedural syllables follow c/k/qu, gh/ngh and the
+//     legal finals
+//   - 14: no Samoan loan letters in procedural names; no Polynesian vowel
+//     three times running
+const AlgorithmVersion = 14
+
+// replayPrefix marks replay tokens; the digit is the token format.
+const replayPrefix = "ng1."
+
+var ErrReplayToken = errors.New("invalid replay token")
+
+// Generator is a seeded, replayable source of names for one profile. The
+// seed is resolved when the Generator is created, so a "random" run still
+// knows its seed and can hand out a replay token.
+type Generator struct {
+	profile NameProfile
+	cfg     ProfileConfig
+	next    int
+	seen    *dedupe
+}
+
+// NewGenerator returns a Generator for p with cfg.Seed resolved.
+func NewGenerator(p NameProfile, cfg ProfileConfig) *Generator {
+	cfg.Seed = ResolveSeed(cfg.Seed)
+	if cfg.Mode == "" {
+		cfg.Mode = p.Info()["name"]
+	}
+	g := &Generator{profile: p, cfg: cfg}
+	if cfg.Unique {
+		g.seen = newDedupe()
+	}
+	return g
+}
+
+// Seed returns the effective master seed of the run.
+func (g *Generator) Seed() int64 { return g.cfg.Seed }
+
+// Config returns the effective config, including the resolved seed.
+func (g *Generator) Config() ProfileConfig { return g.cfg }
+
+// ReplayToken returns the token that replays this run.
+func (g *Generator) ReplayToken() string { return ReplayToken(g.cfg) }
+
+// Next returns the next name of the run. Candidate k uses
+// NameSeed(Seed(), k); with cfg.Unique, repeats are skipped. It ignores
+// cfg.Count, see Stream for a bounded sequence.
+func (g *Generator) Next() (NameResult, error) {
+	for {
+		k := g.next
+		g.next++
+
+		cfg := g.cfg
+		cfg.Seed = NameSeed(g.cfg.Seed, k)
+		res, err := Generate(g.profile, cfg)
+		if err != nil {
+			return NameResult{}, fmt.Errorf("name %d: %w", k, err)
+		}
+		if g.seen == nil {
+			return res, nil
+		}
+		ok, err := g.seen.admit(res)
+		if err != nil {
+			return NameResult{}, err
+		}
+		if ok {
+			return res, nil
+		}
+	}
+}
+
+// Batch runs api.Batch with the generator's effective config.
+func (g *Generator) Batch(ctx context.Context, workers int, emit func(i int, res NameResult) error) error {
+	return Batch(ctx, g.profile, g.cfg, workers, emit)
+}
+
+// replayPayload is the JSON inside a replay token.
+type replayPayload struct {
+	Version int           `json:"v"`
+	Config  ProfileConfig `json:"cfg"`
+}
+
+// ReplayToken encodes cfg (profile name in cfg.Mode, seed, options and
+// constraints) together with AlgorithmVersion. Filters are code, not data,
+// and are not part of the token.
+func ReplayToken(cfg ProfileConfig) string {
+	cfg.Filters = nil
+	b, err := json.Marshal(replayPayload{Version: AlgorithmVersion, Config: cfg})
+	if err != nil {
+		// ProfileConfig contains only plain data once Filters are dropped.
+		panic("api.ReplayToken: " + err.Error())
+	}
+	return replayPrefix + base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseReplayToken decodes a token from ReplayToken. When the token was
+// made by a different AlgorithmVersion, the config is still returned along
+// with an error, since names may differ from the original run.
+func ParseReplayToken(token string) (ProfileConfig, error) {
+	raw, ok := strings.CutPrefix(strings.TrimSpace(token), replayPrefix)
+	if !ok {
+		return ProfileConfig{}, fmt.Errorf("%w: missing %q prefix", ErrReplayToken, replayPrefix)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return ProfileConfig{}, fmt.Errorf("%w: %v", ErrReplayToken, err)
+	}
+	var payload replayPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return ProfileConfig{}, fmt.Errorf("%w: %v", ErrReplayToken, err)
+	}
+	if payload.Version != AlgorithmVersion {
+		return payload.Config, fmt.Errorf("%w: made by algorithm version %d, this is version %d", ErrReplayToken, payload.Version, AlgorithmVersion)
+	}
+	return payload.Config, nil
+}
@@ -0,0 +1,71 @@
+package api_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/english"
+)
+
+func TestReplayTokenRoundTrip(t *testing.T) {
+	cfg := api.ProfileConfig{
+		Mode: english.PROFILE, Seed: 1234, Count: 5, Realism: 40, Gender: "female",
+		IncludeLast: true, Unique: true,
+		Constraints: api.Constraints{Prefix: "M", MaxLen: 8},
+		Filters:     []api.Filter{api.ProfanityFilter()},
+	}
+	g := api.NewGenerator(english.Profile, cfg)
+	token := g.ReplayToken()
+
+	got, err := api.ParseReplayToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := cfg
+	want.Filters = nil
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseReplayToken = %+v, want %+v", got, want)
+	}
+
+	// The replayed run gives the same names.
+	replay := api.NewGenerator(english.Profile, got)
+	for i := range cfg.Count {
+		a, err := g.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := replay.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("name %d: %+v, replayed %+v", i, a, b)
+		}
+	}
+}
+
+func TestParseReplayTokenErrors(t *testing.T) {
+	cfg := api.ProfileConfig{Mode: english.PROFILE, Seed: 99, IncludeLast: true}
+	payload, err := json.Marshal(map[string]any{"v": api.AlgorithmVersion - 1, "cfg": cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := "ng1." + base64.RawURLEncoding.EncodeToString(payload)
+	got, err := api.ParseReplayToken(old)
+	if !errors.Is(err, api.ErrReplayToken) {
+		t.Errorf("older version: got %v, want ErrReplayToken", err)
+	}
+	if !reflect.DeepEqual(got, cfg) {
+		t.Errorf("older version: config %+v, want %+v", got, cfg)
+	}
+
+	for _, token := range []string{"", "abc", "ng1.!!!", "ng1." + base64.RawURLEncoding.EncodeToString([]byte("{"))} {
+		if _, err := api.ParseReplayToken(token); !errors.Is(err, api.ErrReplayToken) {
+			t.Errorf("ParseReplayToken(%q) = %v, want ErrReplayToken", token, err)
+		}
+	}
+}
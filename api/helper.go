@@ -24,6 +24,26 @@ func PickRand[T any](arr []T, r RandLike) T {
 	return arr[r.Intn(len(arr))]
 }
 
+// UseRealPct is the realism ladder most profiles share: the chance, in
+// percent, of a curated name over a procedural one at a realism of 0..100.
+func UseRealPct(realism int) int {
+	switch {
+	case realism >= 95:
+		return 95
+	case realism >= 90:
+		return 90
+	case realism >= 80:
+		return 80
+	case realism >= 70:
+		return 55
+	case realism >= 60:
+		return 35
+	case realism >= 40:
+		return 20
+	}
+	return 5
+}
+
 // NewRand returns a deterministic RNG when cfg.Seed != 0.
 // When cfg.Seed == 0, it returns a time-seeded RNG.
 func NewRand(cfg ProfileConfig) *rand.Rand {
@@ -32,3 +52,37 @@ func NewRand(cfg ProfileConfig) *rand.Rand {
 	}
 	return rand.New(rand.NewSource(time.Now().UnixNano()))
 }
+
+// mixSeed derives the n-th child seed of seed using a splitmix64 step.
+// The result is never 0, since a 0 seed means "random" to NewRand.
+func mixSeed(seed int64, n uint64) int64 {
+	z := uint64(seed) + (n+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	if z == 0 {
+		z = 1
+	}
+	return int64(z)
+}
+
+// Independent seed streams, so e.g. the retries of name 0 never replay
+// the seeds of names 1, 2, ...
+const (
+	streamAttempts uint64 = iota + 1
+	streamNames
+	streamSamples
+)
+
+// childSeed derives the n-th seed of the given stream under seed.
+func childSeed(seed int64, stream, n uint64) int64 {
+	return mixSeed(mixSeed(seed, stream), n)
+}
+
+// randomSeed returns a non-zero time-based seed.
+func randomSeed() int64 {
+	if s := time.Now().UnixNano(); s != 0 {
+		return s
+	}
+	return 1
+}
@@ -0,0 +1,80 @@
+package api
+
+// Built-in filter data. Entries are stored normalized (lowercase ASCII, see
+// normalizeName). Keep substring terms unambiguous: anything that commonly
+// occurs inside real names (e.g. "ass" in "Cassandra", "huy" as a Vietnamese
+// given name) belongs in profanityWords, which only matches whole tokens.
+
+// profanitySubstrings are rejected anywhere inside a name token.
+var profanitySubstrings = []string{
+	// English
+	"fuck", "cunt", "nigger", "nigga", "faggot", "whore", "slut", "bitch",
+	"bastard", "twat", "bollock", "dildo", "rapist", "vagina",
+	"pussy", "jizz", "boob", "hitler", "retard", "douche",
+	// Spanish
+	"mierda", "pendej", "cabron", "putita", "culero", "chinga", "maricon", "gilipollas",
+	// Portuguese
+	"caralh", "buceta", "foder", "viado", "merda",
+	// French
+	"putain", "salope", "connard", "connasse", "enculer", "encule", "merde",
+	// German
+	"scheis", "fotze", "arschloch", "wichser", "hurensohn",
+	// Italian
+	"cazzo", "stronzo", "vaffancul", "puttana", "minchia",
+	// Dutch
+	"klootzak", "kanker", "godver",
+	// Polish / Czech
+	"kurwa", "pierdol", "chuj", "jebac", "kurva", "prdel",
+	// Russian / Ukrainian / South Slavic (romanized)
+	"blyat", "blyad", "pizda", "yebat", "mudak", "pichka", "jebem",
+	// Turkish
+	"orospu", "siktir", "yarrak", "amcik", "gavat",
+	// Arabic (romanized)
+	"sharmout", "sharmut", "kusomak", "kussomak", "ayreh", "manyak",
+	// Hindi / Urdu (romanized)
+	"chutiya", "chutia", "bhenchod", "behenchod", "madarchod", "bhosdi", "gaandu",
+	// Tagalog
+	"putangina", "tangina", "kantot", "pokpok",
+	// Malay / Indonesian
+	"kontol", "memek", "pukimak", "ngentot", "bangsat",
+	// Japanese / Korean / Chinese (romanized)
+	"chinko", "manko", "ssibal", "shibal", "gaesaekki", "caonima", "diaonilaomu",
+	// Swahili / Yoruba (romanized)
+	"kumamako", "oloshi", "ashewo",
+}
+
+// profanityWords are rejected only as complete name tokens.
+var profanityWords = []string{
+	"ass", "arse", "anus", "anal", "butt", "cum", "dick", "cock", "tits", "fag", "hoe", "piss", "poop", "crap",
+	"dicky", "cocky", "cockie", "tit", "titty", "titties", "butty", "butties",
+	"shit", "shitty", "wank", "wanker", "porn", "porno", "penis", "nazi", "nazis",
+	"puta", "puto", "verga", "troia", "culo", "cono", "porra", "bite", "cul", "hure", "arsch",
+	"kut", "lul", "huj", "amk", "kuss", "sibal", "tite", "puki",
+	"kuso", "sex", "sexy", "kill", "dead",
+}
+
+// famousNames are well-known real people across the cultures covered by the
+// built-in profiles. Both name orders are checked.
+var famousNames = []string{
+	// English-speaking world
+	"Michael Jordan", "Michael Jackson", "Taylor Swift", "Elvis Presley", "Donald Trump", "Joe Biden",
+	"Barack Obama", "Hillary Clinton", "Bill Clinton", "George Bush", "John Kennedy", "Abraham Lincoln",
+	"George Washington", "Elon Musk", "Bill Gates", "Steve Jobs", "Mark Zuckerberg", "Jeff Bezos",
+	"Tom Hanks", "Tom Cruise", "Brad Pitt", "Will Smith", "Kim Kardashian", "Justin Bieber",
+	"David Beckham", "Harry Styles", "Emma Watson", "Daniel Radcliffe", "Paul McCartney", "John Lennon",
+	"Elizabeth Taylor", "Marilyn Monroe", "Oprah Winfrey", "Serena Williams", "Tiger Woods", "LeBron James",
+	"Michael Phelps", "Stephen King", "William Shakespeare", "Charles Darwin", "Isaac Newton", "Winston Churchill",
+	"Princess Diana", "Adolf Hitler", "Osama Laden", "Charles Manson", "Ted Bundy", "Jeffrey Epstein",
+	// Continental Europe / Latin America
+	"Lionel Messi", "Cristiano Ronaldo", "Pablo Picasso", "Frida Kahlo", "Salma Hayek", "Penelope Cruz",
+	"Pablo Escobar", "Fidel Castro", "Che Guevara", "Diego Maradona", "Rafael Nadal", "Gabriel Marquez",
+	"Vladimir Putin", "Joseph Stalin", "Vladimir Lenin", "Leo Tolstoy", "Angela Merkel", "Albert Einstein",
+	"Karl Marx", "Emmanuel Macron", "Napoleon Bonaparte", "Benito Mussolini", "Sophia Loren", "Luciano Pavarotti",
+	"Zlatan Ibrahimovic", "Greta Thunberg", "Bjorn Borg", "Novak Djokovic",
+	// Asia / Middle East / Africa
+	"Jackie Chan", "Bruce Lee", "Yao Ming", "Mao Zedong", "Xi Jinping", "Jet Li", "Lucy Liu",
+	"Shinzo Abe", "Ken Watanabe", "Hayao Miyazaki", "Yoko Ono", "Kim Jong", "Kim Yuna", "Park Chanwook",
+	"Narendra Modi", "Mahatma Gandhi", "Indira Gandhi", "Shah Rukh Khan", "Amitabh Bachchan", "Priyanka Chopra",
+	"Ho Chi Minh", "Saddam Hussein", "Yasser Arafat", "Mohamed Salah", "Benjamin Netanyahu", "Golda Meir",
+	"Nelson Mandela", "Haile Selassie", "Kofi Annan", "Idi Amin", "Recep Erdogan", "Imran Khan",
+}
@@ -0,0 +1,335 @@
+package api
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxNicknames caps how many nicknames Nicknames returns.
+const maxNicknames = 6
+
+// NicknameRules describes how a culture forms informal names. Curated
+// nicknames come first; the rules then shorten a name of three or more
+// syllables to its first syllable ("Fran", "Wil") and derive diminutives
+// from that stem. Shorter names are not clipped.
+type NicknameRules struct {
+	// Curated maps a lowercase given name to its usual nicknames
+	// ("william" -> Bill, Will, Liam).
+	Curated map[string][]string
+
+	// Suffixes are diminutive endings added to the stem of a name of any
+	// gender ("y" -> "Willy", "ito" -> "Franito"). An ending in -o turns
+	// into -a for women's names ("Mariana" -> "Marita"). Endings starting
+	// with "-" are attached with the hyphen to the whole name, and with
+	// OpenStem to the stem as well ("-chan"). "x|y" picks x after a
+	// consonant and y after a vowel ("-ah|-ya").
+	Suffixes []string
+
+	// MaleSuffixes and FemaleSuffixes are only added to men's and women's
+	// names ("-ukas" and "-ute" in Lithuanian).
+	MaleSuffixes   []string
+	FemaleSuffixes []string
+
+	// Prefixes are familiar prefixes put before the stem ("Xiao", "A"), or
+	// before the whole name with NoClip or a short name ("Bé Hương").
+	Prefixes []string
+
+	// Codas, when set, are the only consonants a stem may end in, and a
+	// stem is always one syllable ("cangwei" -> "cang" with "ng").
+	Codas []string
+
+	OpenStem    bool // clip after the first vowel ("Yu"), not after the following consonant ("Yuk")
+	Reduplicate bool // double the stem ("Lingling", "Jiji")
+	NoClip      bool // never offer the bare stem as a nickname
+}
+
+// Nicknamer is implemented by profiles with their own nickname rules.
+type Nicknamer interface {
+	NicknameRules() NicknameRules
+}
+
+// Nicknames returns informal variants of a given name under p's rules, most
+// common first. gender is the gender the name was generated for ("male",
+// "female", or anything else when unknown); a name in only one of p's
+// curated gender lists takes that gender. Derived nicknames that are
+// another of p's curated names ("Mary" from "Mark") are left out. Profiles
+// without rules get the bare first-syllable stem. The result is
+// deterministic and never contains name itself.
+func Nicknames(p NameProfile, name, gender string) []string {
+	var rules NicknameRules
+	if np, ok := p.(Nicknamer); ok {
+		rules = np.NicknameRules()
+	}
+	var names map[string]string
+	if ip, ok := p.(Inventoried); ok {
+		names = nameGenders(ip.Inventory())
+	}
+	return rules.apply(name, gender, names)
+}
+
+// Apply derives the nicknames of a name of the given gender under the
+// rules alone, without a profile's curated names.
+func (nr NicknameRules) Apply(name, gender string) []string {
+	return nr.apply(name, gender, nil)
+}
+
+// apply is Apply; names maps the profile's lowercase curated given names
+// to "male", "female" or "" (both or neutral).
+func (nr NicknameRules) apply(name, gender string, names map[string]string) []string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	lower := strings.ToLower(name)
+	if g, ok := names[lower]; ok && g != "" {
+		gender = g
+	}
+
+	var out []string
+	seen := map[string]bool{lower: true}
+	add := func(n string) {
+		key := strings.ToLower(n)
+		if n == "" || seen[key] || len(out) >= maxNicknames {
+			return
+		}
+		seen[key] = true
+		out = append(out, n)
+	}
+	// derived adds a rule-made nickname unless it is another curated name.
+	derived := func(n string) {
+		if _, ok := names[strings.ToLower(n)]; !ok {
+			add(n)
+		}
+	}
+
+	for _, n := range nr.Curated[lower] {
+		add(n)
+	}
+	if head, _, ok := strings.Cut(name, " "); ok {
+		// Compound names ("Jose Luis") take the nicknames of their first
+		// part; with NoClip, of the part they are called by ("Thu Hương").
+		if nr.NoClip {
+			head = name[strings.LastIndexByte(name, ' ')+1:]
+		}
+		for _, n := range nr.apply(head, gender, names) {
+			add(n)
+		}
+		return out
+	}
+
+	suffixes := nr.Suffixes
+	switch gender {
+	case "male":
+		suffixes = append(slices.Clip(suffixes), nr.MaleSuffixes...)
+	case "female":
+		suffixes = append(slices.Clip(suffixes), nr.FemaleSuffixes...)
+	}
+	for _, suf := range suffixes {
+		if strings.HasPrefix(suf, "-") {
+			add(name + alternate(suf, lower))
+		}
+	}
+
+	syllables := syllableCount([]rune(lower))
+	short := syllables <= 2 && nr.Codas == nil
+	whole := nr.NoClip || short
+	for _, pre := range nr.Prefixes {
+		if whole {
+			add(pre + " " + name)
+		} else if stem := nr.clipStem(lower); stem != "" {
+			add(pre + " " + Title(stem))
+		}
+	}
+	if short {
+		// Clipping one or two syllables leaves too little ("Noa" from
+		// "Noah", "Mary" from "Mark").
+		if nr.Reduplicate && syllables == 1 {
+			derived(Title(lower + lower))
+		}
+		return out
+	}
+
+	stem := nr.clipStem(lower)
+	if stem == "" {
+		return out
+	}
+	feminine := gender == "female" || (gender != "male" && strings.HasSuffix(lower, "a"))
+	for _, suf := range suffixes {
+		if feminine && strings.HasSuffix(suf, "o") {
+			suf = suf[:len(suf)-1] + "a"
+		}
+		if strings.HasPrefix(suf, "-") {
+			if nr.OpenStem {
+				derived(Title(stem) + alternate(suf, stem))
+			}
+			continue
+		}
+		suf = alternate(suf, stem)
+		if strings.HasSuffix(lower, suf) || !joins(stem, suf) {
+			continue // "Yaotzin" + "tzin", "Alek" + "ka"
+		}
+		if n := attachSuffix(stem, suf); n != "" {
+			derived(Title(n))
+		}
+	}
+	if nr.Reduplicate {
+		derived(Title(stem + stem))
+	}
+	if !nr.NoClip && len([]rune(stem)) >= 3 {
+		derived(Title(stem))
+	}
+	return out
+}
+
+// joins reports whether suf can follow stem: not when the stem already ends
+// in it ("alek" + "ek"), or ends in the consonant it starts with ("alek" +
+// "ka").
+func joins(stem, suf string) bool {
+	if suf == "" || strings.HasSuffix(stem, suf) {
+		return false
+	}
+	rs, first := []rune(stem), []rune(suf)[0]
+	return len(rs) == 0 || rs[len(rs)-1] != first || isVowel(first)
+}
+
+// syllableCount counts the vowel groups of a lowercase name.
+func syllableCount(rs []rune) int {
+	n := 0
+	for i := range rs {
+		if isSyllableVowel(rs, i) && (i == 0 || !isSyllableVowel(rs, i-1)) {
+			n++
+		}
+	}
+	return n
+}
+
+// isSyllableVowel reports whether rs[i] is a vowel; a y before a vowel is
+// a consonant ("Yuki", "Diya").
+func isSyllableVowel(rs []rune, i int) bool {
+	if rs[i] == 'y' && i+1 < len(rs) && isVowel(rs[i+1]) {
+		return false
+	}
+	return isVowel(rs[i])
+}
+
+// nameGenders maps the lowercase curated given names of inv to the gender
+// of the lists they are in: "male", "female", or "" for both or neutral.
+func nameGenders(inv Inventory) map[string]string {
+	names := make(map[string]string, len(inv.FirstMale)+len(inv.FirstFemale)+len(inv.FirstNeutral))
+	mark := func(list []string, gender string) {
+		for _, n := range list {
+			key, g := strings.ToLower(n), gender
+			if prev, ok := names[key]; ok && prev != g {
+				g = ""
+			}
+			names[key] = g
+		}
+	}
+	mark(inv.FirstMale, "male")
+	mark(inv.FirstFemale, "female")
+	for _, n := range inv.FirstNeutral {
+		names[strings.ToLower(n)] = ""
+	}
+	return names
+}
+
+// alternate resolves an "x|y" suffix for the word it follows.
+func alternate(suf, word string) string {
+	x, y, ok := strings.Cut(suf, "|")
+	if !ok {
+		return suf
+	}
+	if rs := []rune(word); len(rs) > 0 && isVowel(rs[len(rs)-1]) {
+		return y
+	}
+	return x
+}
+
+// clipStem returns the first syllable of a lowercase name: its onset, the
+// first vowel group and (unless OpenStem) one following consonant. Stems
+// shorter than three letters take the next syllable too, so "aleksandr"
+// clips to "alek" rather than "al". A one-syllable name is its own stem
+// ("juan").
+func (nr NicknameRules) clipStem(name string) string {
+	rs := []rune(name)
+	vowel := func(i int) bool { return isSyllableVowel(rs, i) }
+
+	end, syllables := 0, 0
+	for end < len(rs) {
+		for end < len(rs) && !vowel(end) {
+			end++
+		}
+		if end == len(rs) {
+			break
+		}
+		for end < len(rs) && vowel(end) {
+			end++
+		}
+		syllables++
+		if nr.Codas != nil {
+			end += codaLen(rs[end:], nr.Codas)
+			break
+		}
+		// y, w, h and q make poor codas ("Diy", "Enriq"); leave them to
+		// the next syllable.
+		if !nr.OpenStem && end < len(rs) && !strings.ContainsRune("ywhq", rs[end]) {
+			end++
+		}
+		if end >= 3 || syllables > 1 || nr.OpenStem {
+			break
+		}
+	}
+	stem := string(rs[:min(end, len(rs))])
+	if strings.IndexFunc(stem, func(r rune) bool { return !unicode.IsLetter(r) }) >= 0 {
+		return ""
+	}
+	return stem
+}
+
+// codaLen is the length of the longest coda in codas that rest starts with
+// and that is not the onset of a following syllable.
+func codaLen(rest []rune, codas []string) int {
+	best := 0
+	for _, c := range codas {
+		n := len([]rune(c))
+		if n <= best || !strings.HasPrefix(string(rest), c) {
+			continue
+		}
+		if n < len(rest) && isVowel(rest[n]) {
+			continue
+		}
+		best = n
+	}
+	return best
+}
+
+// attachSuffix joins a lowercase stem and suffix: a vowel clash drops the
+// stem's final vowel ("yu" + "ito" -> "yito"), and "y"/"ie" double a single
+// final consonant after a single vowel ("wil" -> "willy", "mic" ->
+// "micky"). English-style "y"/"ie" is not added to vowel-final stems.
+func attachSuffix(stem, suf string) string {
+	rs := []rune(stem)
+	n := len(rs)
+	if n == 0 || suf == "" {
+		return stem + suf
+	}
+	first := []rune(suf)[0]
+	last := rs[n-1]
+	english := suf == "y" || suf == "ie"
+	switch {
+	case english && isVowel(last):
+		return ""
+	case isVowel(last) && isVowel(first) && first != 'y':
+		return string(rs[:n-1]) + suf
+	case english && n >= 2 && isVowel(rs[n-2]) && (n < 3 || !isVowel(rs[n-3])):
+		switch last {
+		case 'c', 'k':
+			return string(rs[:n-1]) + "ck" + suf
+		case 'r', 'w', 'x', 'h', 'j':
+			return stem + suf
+		}
+		return stem + string(last) + suf
+	}
+	return stem + suf
+}
@@ -0,0 +1,84 @@
+package api_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/amharic"
+	"github.com/nsa-yoda/namegen/plugins/baltic"
+	"github.com/nsa-yoda/namegen/plugins/chinese"
+	"github.com/nsa-yoda/namegen/plugins/english"
+	"github.com/nsa-yoda/namegen/plugins/italian"
+	"github.com/nsa-yoda/namegen/plugins/nahuatl"
+	"github.com/nsa-yoda/namegen/plugins/nordic"
+	"github.com/nsa-yoda/namegen/plugins/slavic"
+	"github.com/nsa-yoda/namegen/plugins/spanish"
+	"github.com/nsa-yoda/namegen/plugins/vietnamese"
+)
+
+func TestNicknames(t *testing.T) {
+	tests := []struct {
+		p        api.NameProfile
+		name     string
+		gender   string
+		want     []string // each must be offered
+		wantNone []string // none may be offered
+	}{
+		{english.Profile, "William", "male", []string{"Bill"}, nil},
+		{slavic.Profile, "Aleksandr", "male", []string{"Sasha"}, []string{"Alekka", "Alekek"}},
+		{spanish.Profile, "Francisco", "male", []string{"Paco"}, nil},
+		{italian.Profile, "Giuseppe", "male", []string{"Beppe"}, nil},
+		{amharic.Profile, "Yohannes", "male", []string{"Jo"}, nil},
+
+		// Short names are not clipped, and nothing may turn into another
+		// given name or the other gender's diminutive.
+		{english.Profile, "Mark", "male", nil, []string{"Mary", "Marie", "Marky", "Mar"}},
+		{english.Profile, "Sarah", "female", nil, []string{"Sary", "Sarie"}},
+		{english.Profile, "Noah", "male", nil, []string{"Noa"}},
+		{baltic.Profile, "Jonas", "male", nil, []string{"Jonute", "Jonukas"}},
+		{baltic.Profile, "Laura", "female", nil, []string{"Laurukas"}},
+		{nordic.Profile, "Sven", "male", nil, []string{"Svena", "Svene"}},
+		{nahuatl.Profile, "Yaotzin", "male", nil, []string{"Yaottzin"}},
+
+		// Prefixes go before the whole name when it is not clipped.
+		{vietnamese.Profile, "Hương", "female", []string{"Bé Hương"}, []string{"Bé Hươn"}},
+		{vietnamese.Profile, "Thu Hương", "female", []string{"Bé Hương"}, nil},
+		{chinese.Profile, "Cang", "male", []string{"Xiao Cang"}, nil},
+	}
+	for _, tt := range tests {
+		got := api.Nicknames(tt.p, tt.name, tt.gender)
+		for _, w := range tt.want {
+			if !slices.Contains(got, w) {
+				t.Errorf("%T %s: got %q, want %q among them", tt.p, tt.name, got, w)
+			}
+		}
+		for _, w := range tt.wantNone {
+			if slices.Contains(got, w) {
+				t.Errorf("%T %s: got %q, which should not contain %q", tt.p, tt.name, got, w)
+			}
+		}
+	}
+}
+
+func TestNicknameRulesApply(t *testing.T) {
+	tests := []struct {
+		rules  api.NicknameRules
+		name   string
+		gender string
+		want   []string
+	}{
+		{api.NicknameRules{MaleSuffixes: []string{"ukas"}, FemaleSuffixes: []string{"ute"}}, "Vytautas", "male", []string{"Vytukas", "Vyt"}},
+		{api.NicknameRules{MaleSuffixes: []string{"ukas"}, FemaleSuffixes: []string{"ute"}}, "Gabriele", "female", []string{"Gabute", "Gab"}},
+		{api.NicknameRules{Suffixes: []string{"ito"}}, "Mariana", "female", []string{"Marita", "Mar"}},
+		{api.NicknameRules{Suffixes: []string{"ito"}}, "Alessandro", "male", []string{"Alesito", "Ales"}},
+		{api.NicknameRules{Suffixes: []string{"-chan"}, NoClip: true}, "Nao", "", []string{"Nao-chan"}},
+		{api.NicknameRules{Prefixes: []string{"Bé"}, NoClip: true}, "Cường", "male", []string{"Bé Cường"}},
+		{api.NicknameRules{Suffixes: []string{"y"}}, "Tom", "male", nil},
+	}
+	for _, tt := range tests {
+		if got := tt.rules.Apply(tt.name, tt.gender); !slices.Equal(got, tt.want) {
+			t.Errorf("Apply(%q, %q) = %q, want %q", tt.name, tt.gender, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import "strings"
+
+// NameParts is the structured form of a name, filled in by profiles that
+// build names from parts. It keeps what the display strings lose, such as the
+// dictionary form of an inflected surname, so related names (a husband and
+// wife, parents and children) can be derived from the same root.
+type NameParts struct {
+	Locale      string      `json:"locale,omitempty"`      // sub-locale the name follows, e.g. "pl", "ru", "lt"
+	Convention  string      `json:"convention,omitempty"`  // naming convention used, e.g. "patronymic"
+	SurnameBase string      `json:"surnameBase,omitempty"` // dictionary (masculine) form: "Kowalski", "Kazlauskas"
+	SurnameForm SurnameForm `json:"surnameForm,omitempty"` // form NameResult.Last is in
+
+	// Parents' given names, where the name is derived from them
+	// (patronymics and matronymics).
+	Father string `json:"father,omitempty"`
+	Mother string `json:"mother,omitempty"`
+
+	// Compound names (Iberian): the given-name components, and the
+	// surnames in display order with their particles ("de la Fuente").
+	GivenNames []string `json:"givenNames,omitempty"`
+	Surnames   []string `json:"surnames,omitempty"`
+	Paternal   string   `json:"paternal,omitempty"` // the surname from the father
+	Maternal   string   `json:"maternal,omitempty"` // the surname from the mother
+
+	// Middle is the name between family and given name where it is a slot
+	// of its own (Vietnamese tên đệm: Văn, Thị).
+	Middle string `json:"middle,omitempty"`
+
+	// Chain names (Arabic nasab): the ancestors' given names, father first.
+	// Father repeats the first one.
+	Ancestors []string `json:"ancestors,omitempty"`
+
+	// Classical Arabic components besides the given name (ism) and nasab.
+	Kunya string `json:"kunya,omitempty"` // "Abu Yusuf", "Umm Ali"
+	Laqab string `json:"laqab,omitempty"` // epithet: "al-Rashid", "Salah al-Din"
+	Nisba string `json:"nisba,omitempty"` // origin or tribe: "al-Baghdadi"
+
+	// Full is the whole name in its traditional order where that is not
+	// First + Last, e.g. with a kunya before the given name.
+	Full string `json:"full,omitempty"`
+}
+
+// givenParticles are the words that join the components of a compound
+// given name to what follows ("Maria de los Angeles").
+var givenParticles = map[string]bool{
+	"de": true, "del": true, "la": true, "las": true, "los": true,
+	"da": true, "das": true, "do": true, "dos": true,
+}
+
+// GivenComponents splits a compound given name into its components for
+// NameParts.GivenNames, keeping particles with the word they introduce:
+// "Maria de los Angeles" is "Maria" and "de los Angeles".
+func GivenComponents(name string) []string {
+	var out []string
+	lead := ""
+	for _, w := range strings.Fields(name) {
+		switch {
+		case givenParticles[strings.ToLower(w)]:
+			lead += w + " "
+		case lead != "":
+			out = append(out, lead+w)
+			lead = ""
+		default:
+			out = append(out, w)
+		}
+	}
+	if lead = strings.TrimSpace(lead); lead != "" {
+		out = append(out, lead)
+	}
+	return out
+}
+
+// MiddleName returns res's middle name (NameParts.Middle), or "".
+func MiddleName(res NameResult) string {
+	if res.Parts == nil {
+		return ""
+	}
+	return res.Parts.Middle
+}
+
+// OrderedName writes res family name first or given name first, with the
+// middle name between: "Phan Thị Phương" or "Phương Thị Phan".
+func OrderedName(res NameResult, familyFirst bool) string {
+	parts := []string{res.First, MiddleName(res), res.Last}
+	if familyFirst {
+		parts = []string{res.Last, MiddleName(res), res.First}
+	}
+	var out []string
+	for _, s := range parts {
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// Naming conventions shared by several profiles (ProfileConfig.Convention).
+const (
+	ConventionSurname    = "surname"       // inherited family names
+	ConventionPatronymic = "patronymic"    // surname formed from the father's given name
+	ConventionMatronymic = "matronymic"    // surname formed from the mother's given name
+	ConventionDouble     = "double"        // paternal and maternal surnames (Iberian)
+	ConventionJoined     = "joined"        // double surnames joined by a conjunction ("Garcia y Lopez")
+	ConventionChain      = "chain"         // given name + father's + grandfather's given names (+ family name)
+	ConventionClassical  = "classical"     // Arabic kunya, ism, laqab, nasab (ibn/bint chain) and nisba
+	ConventionInitials   = "initials"      // father's or house name's initial before the given name ("R. Karthik")
+	ConventionNeutral    = "caste-neutral" // surnames that do not mark caste or community (Kumar, Singh/Kaur)
+)
+
+// SurnameForm is the grammatical form of an inflected surname.
+type SurnameForm string
+
+const (
+	SurnameMasculine SurnameForm = "masculine" // also the dictionary form
+	SurnameFeminine  SurnameForm = "feminine"
+	SurnameMarried   SurnameForm = "married" // a wife's form where it differs (Lithuanian -iene)
+	SurnameMaiden    SurnameForm = "maiden"  // an unmarried woman's form (Lithuanian -aite/-yte/-ute)
+)
+
+// SurnameInflector is implemented by profiles whose surnames inflect for
+// gender or marital status. locale is a NameParts.Locale value; an empty
+// locale lets the profile infer it from the surname.
+type SurnameInflector interface {
+	InflectSurname(base string, form SurnameForm, locale string) string
+}
+
+// InflectSurname puts base into form under p's rules. Profiles without
+// surname morphology return base unchanged.
+func InflectSurname(p NameProfile, base string, form SurnameForm, locale string) string {
+	if si, ok := p.(SurnameInflector); ok {
+		return si.InflectSurname(base, form, locale)
+	}
+	return base
+}
+
+// PatronymicFormer is implemented by profiles that can form a surname from a
+// parent's given name ("Jón" -> "Jónsson"/"Jónsdóttir"). matronymic says the
+// parent is the mother; gender is the child's.
+type PatronymicFormer interface {
+	FormPatronymic(parent string, matronymic bool, gender, locale string) string
+}
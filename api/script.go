@@ -0,0 +1,58 @@
+package api
+
+// Scripts a name can be rendered in (ProfileConfig.Script). Profiles with
+// more than one native script also accept the names they list in Scripts.
+const (
+	ScriptLatin  = "latin"  // romanized; First and Last are always in this script
+	ScriptNative = "native" // also render the name in the culture's script, in NameResult.Native
+)
+
+// Scripted is implemented by profiles that can render names in a native
+// script. The first script is the one ScriptNative selects.
+type Scripted interface {
+	Scripts() []string
+}
+
+// Scripts lists the native scripts p can render, nil if none.
+func Scripts(p NameProfile) []string {
+	if sc, ok := p.(Scripted); ok {
+		return sc.Scripts()
+	}
+	return nil
+}
+
+// NativeScript resolves cfg.Script for p: the native script to render in,
+// or "" for Latin only (also when p has no such script).
+func NativeScript(p NameProfile, cfg ProfileConfig) string {
+	scripts := Scripts(p)
+	if len(scripts) == 0 {
+		return ""
+	}
+	switch cfg.Script {
+	case "", ScriptLatin:
+		return ""
+	case ScriptNative:
+		return scripts[0]
+	}
+	for _, s := range scripts {
+		if s == cfg.Script {
+			return s
+		}
+	}
+	return ""
+}
+
+// Romanizer is implemented by profiles that can romanize their names in more
+// than one system (ProfileConfig.Romanization). The first one is the default.
+type Romanizer interface {
+	Romanizations() []string
+}
+
+// Romanizations lists the romanization systems p accepts, nil when it only
+// has its default one.
+func Romanizations(p NameProfile) []string {
+	if ro, ok := p.(Romanizer); ok {
+		return ro.Romanizations()
+	}
+	return nil
+}
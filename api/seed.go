@@ -0,0 +1,53 @@
+package api
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// SeedFromString hashes s (FNV-1a, 64 bit) into a seed. The mapping is
+// stable across platforms and releases, so "npc:guard:17" always names the
+// same seed. The result is never 0.
+func SeedFromString(s string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	if v := int64(h.Sum64()); v != 0 {
+		return v
+	}
+	return 1
+}
+
+// ParseSeed turns a user-supplied seed into an int64: "" is 0 (random),
+// integers are used as-is, anything else is hashed with SeedFromString.
+func ParseSeed(s string) int64 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v
+	}
+	return SeedFromString(s)
+}
+
+// DeriveSeed derives a child seed from parent by walking labels, e.g.
+// DeriveSeed(world, "npc", "guard", "17"). Each level depends on every label
+// above it and on their order, and sibling labels give unrelated seeds.
+// With no labels it returns parent. Derived seeds are never 0.
+func DeriveSeed(parent int64, labels ...string) int64 {
+	seed := parent
+	for _, label := range labels {
+		seed = mixSeed(seed, uint64(SeedFromString(label)))
+	}
+	return seed
+}
+
+// ResolveSeed returns seed, or a fresh random non-zero seed when seed is 0.
+// Resolve once up front and keep the result to be able to replay a run.
+func ResolveSeed(seed int64) int64 {
+	if seed != 0 {
+		return seed
+	}
+	return randomSeed()
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+// DefaultSpaceSamples is the sample size EstimateSpace draws.
+const DefaultSpaceSamples = 20000
+
+// Inventory is the raw material a profile draws from. Profiles implement
+// Inventoried so EstimateSpace can count curated names exactly and size the
+// procedural space from the syllable parts.
+type Inventory struct {
+	FirstMale    []string
+	FirstFemale  []string
+	FirstNeutral []string
+	Last         []string
+
+	// NeutralMixes is true when a "neutral" request may also draw from the
+	// male and female lists.
+	NeutralMixes bool
+
+	// Procedural syllable parts: onset + nucleus + coda.
+	Onsets []string
+	Nuclei []string
+	Codas  []string
+
+	GivenEndings   []string
+	SurnameEndings []string
+}
+
+// Inventoried is implemented by profiles that expose their Inventory.
+type Inventoried interface {
+	Inventory() Inventory
+}
+
+// ComponentSpace describes how many distinct values one name component
+// (first, last or full name) can take.
+type ComponentSpace struct {
+	Curated    float64 `json:"curated"`    // exact distinct curated values reachable for the config
+	Procedural float64 `json:"procedural"` // estimated procedural values (inventory ^ observed syllable counts)
+	Observed   int     `json:"observed"`   // distinct values seen in the sample
+	Chao1      float64 `json:"chao1"`      // Chao1 lower-bound estimate of all reachable values
+	Effective  float64 `json:"effective"`  // 1/sum(p^2): uniform-equivalent size, 0 if no repeats seen
+	RealShare  float64 `json:"realShare"`  // fraction of samples that came from curated lists
+}
+
+// Total is the curated plus procedural cardinality.
+func (c ComponentSpace) Total() float64 {
+	return c.Curated + c.Procedural
+}
+
+// CollisionProbability is the birthday-bound probability that n names
+// drawn independently contain at least one duplicate. It treats the space as
+// uniform over 1/collisionRate values, computing the exact product for
+// moderate n and the exponential approximation beyond that.
+func (c ComponentSpace) CollisionProbability(n int) float64 {
+	q := c.collisionRate()
+	if q == 0 || n < 2 {
+		return 0
+	}
+	size := 1 / q
+	if float64(n) > size {
+		return 1
+	}
+	if n <= 10_000_000 {
+		logNone := 0.0
+		for i := 1; i < n; i++ {
+			logNone += math.Log1p(-float64(i) / size)
+		}
+		return -math.Expm1(logNone)
+	}
+	pairs := float64(n) * float64(n-1) / 2
+	return -math.Expm1(-pairs * q)
+}
+
+// ExpectedCollisions is the expected number of duplicate pairs among n names.
+func (c ComponentSpace) ExpectedCollisions(n int) float64 {
+	return float64(n) * float64(n-1) / 2 * c.collisionRate()
+}
+
+// collisionRate is the probability that two independent draws are equal.
+// It falls back to a uniform 1/Total when the sample saw no repeats.
+func (c ComponentSpace) collisionRate() float64 {
+	switch {
+	case c.Effective > 0:
+		return 1 / c.Effective
+	case c.Total() > 0:
+		return 1 / c.Total()
+	case c.Chao1 > 0:
+		return 1 / c.Chao1
+	}
+	return 0
+}
+
+// Space is the result of EstimateSpace.
+type Space struct {
+	Profile string         `json:"profile"`
+	Samples int            `json:"samples"`
+	First   ComponentSpace `json:"first"`
+	Last    ComponentSpace `json:"last"`
+	Full    ComponentSpace `json:"full"`
+}
+
+// EstimateSpace is EstimateSpaceSamples with DefaultSpaceSamples.
+func EstimateSpace(p NameProfile, cfg ProfileConfig) (Space, error) {
+	return EstimateSpaceSamples(p, cfg, DefaultSpaceSamples)
+}
+
+// EstimateSpaceSamples reports how many distinct names p can produce for
+// cfg. Curated cardinality is exact when p implements Inventoried;
+// procedural cardinality is estimated from the syllable inventory and the
+// syllable counts observed in the sample; collision statistics come from
+// sampling names through Generate (so constraints and filters apply).
+func EstimateSpaceSamples(p NameProfile, cfg ProfileConfig, samples int) (Space, error) {
+	if samples <= 0 {
+		samples = DefaultSpaceSamples
+	}
+	sp := Space{Profile: p.Info()["name"], Samples: samples}
+
+	base := ResolveSeed(cfg.Seed)
+
+	firsts := make([]string, 0, samples)
+	lasts := make([]string, 0, samples)
+	fulls := make([]string, 0, samples)
+	sampleCfg := cfg
+	for i := 0; i < samples; i++ {
+		sampleCfg.Seed = childSeed(base, streamSamples, uint64(i))
+		res, err := Generate(p, sampleCfg)
+		if err != nil {
+			return sp, err
+		}
+		firsts = append(firsts, res.First)
+		lasts = append(lasts, res.Last)
+		fulls = append(fulls, res.First+" "+res.Last)
+	}
+
+	inv, hasInv := Inventory{}, false
+	if ip, ok := p.(Inventoried); ok {
+		inv, hasInv = ip.Inventory(), true
+	}
+
+	var givenPool []string
+	switch cfg.Gender {
+	case "male":
+		givenPool = inv.FirstMale
+	case "female":
+		givenPool = inv.FirstFemale
+	default:
+		givenPool = inv.FirstNeutral
+		if inv.NeutralMixes {
+			givenPool = slices.Concat(givenPool, inv.FirstMale, inv.FirstFemale)
+		}
+	}
+
+	syl := syllableSpace(inv.Onsets, inv.Nuclei, inv.Codas)
+	sp.First = componentSpace(firsts, givenPool, syl, inv.GivenEndings, hasInv)
+	if !cfg.IncludeLast {
+		sp.Full = sp.First
+		return sp, nil
+	}
+	sp.Last = componentSpace(lasts, inv.Last, syl, inv.SurnameEndings, hasInv)
+	sp.Full = sampleStats(fulls)
+	sp.Full.Curated = sp.First.Curated * sp.Last.Curated
+	sp.Full.Procedural = sp.First.Total()*sp.Last.Total() - sp.Full.Curated
+	sp.Full.RealShare = sp.First.RealShare * sp.Last.RealShare
+	return sp, nil
+}
+
+// componentSpace combines exact curated counts, the procedural estimate and
+// sample statistics for one name component.
+func componentSpace(values, curated []string, sylSpace float64, endings []string, hasInv bool) ComponentSpace {
+	cs := sampleStats(values)
+	if !hasInv {
+		return cs
+	}
+
+	pool := distinctFold(curated)
+	cs.Curated = float64(len(pool))
+
+	// Syllable counts actually used by procedural names in the sample act
+	// as the profile's templates.
+	curatedHits := 0
+	templates := map[int]bool{}
+	for _, v := range values {
+		if pool[strings.ToLower(v)] {
+			curatedHits++
+			continue
+		}
+		if v != "" {
+			templates[CountSyllables(v)] = true
+		}
+	}
+	if len(values) > 0 {
+		cs.RealShare = float64(curatedHits) / float64(len(values))
+	}
+
+	if sylSpace > 0 {
+		ends := math.Max(1, float64(len(distinctFold(endings))))
+		for k := range templates {
+			cs.Procedural += math.Pow(sylSpace, float64(max(k, 1))) * ends
+		}
+	}
+	return cs
+}
+
+// sampleStats computes observed, Chao1 and effective cardinality.
+func sampleStats(values []string) ComponentSpace {
+	counts := map[string]int{}
+	for _, v := range values {
+		counts[v]++
+	}
+	var f1, f2 float64
+	var sumSq float64
+	for _, c := range counts {
+		switch c {
+		case 1:
+			f1++
+		case 2:
+			f2++
+		}
+		sumSq += float64(c) * float64(c-1)
+	}
+
+	cs := ComponentSpace{Observed: len(counts)}
+	d := float64(len(counts))
+	if f2 > 0 {
+		cs.Chao1 = d + f1*f1/(2*f2)
+	} else {
+		cs.Chao1 = d + f1*(f1-1)/2
+	}
+
+	// Effective stays 0 when the sample saw no repeats at all.
+	m := float64(len(values))
+	if m > 1 && sumSq > 0 {
+		cs.Effective = m * (m - 1) / sumSq
+	}
+	return cs
+}
+
+// syllableSpace is the number of distinct onset+nucleus+coda combinations.
+func syllableSpace(onsets, nuclei, codas []string) float64 {
+	if len(nuclei) == 0 {
+		return 0
+	}
+	n := float64(len(distinctFold(nuclei)))
+	if len(onsets) > 0 {
+		n *= float64(len(distinctFold(onsets)))
+	}
+	if len(codas) > 0 {
+		n *= float64(len(distinctFold(codas)))
+	}
+	return n
+}
+
+func distinctFold(lists ...[]string) map[string]bool {
+	out := map[string]bool{}
+	for _, l := range lists {
+		for _, s := range l {
+			out[strings.ToLower(s)] = true
+		}
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+var ErrSpaceExhausted = errors.New("no unique names left")
+
+// Stream yields names lazily:
+//
+//	for res, err := range api.Stream(ctx, profile, cfg) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(res.First, res.Last)
+//	}
+//
+// It yields cfg.Count names, or never stops when cfg.Count <= 0. Candidate k
+// is generated through Generate (constraints and filters apply) with
+// NameSeed(cfg.Seed, k); with cfg.Unique, candidates already seen are
+// skipped. A seeded Stream therefore yields exactly what Batch emits for the
+// same config. On an error, or when ctx is cancelled, Stream yields the
+// error once and stops. Use NewGenerator directly to learn the resolved
+// seed or a replay token of a random run.
+func Stream(ctx context.Context, p NameProfile, cfg ProfileConfig) iter.Seq2[NameResult, error] {
+	return func(yield func(NameResult, error) bool) {
+		g := NewGenerator(p, cfg)
+		for emitted := 0; cfg.Count <= 0 || emitted < cfg.Count; emitted++ {
+			if err := ctx.Err(); err != nil {
+				yield(NameResult{}, err)
+				return
+			}
+			res, err := g.Next()
+			if err != nil {
+				yield(NameResult{}, err)
+				return
+			}
+			if !yield(res, nil) {
+				return
+			}
+		}
+	}
+}
+
+// dedupe tracks names already produced for cfg.Unique and gives up after
+// too many duplicates in a row, which means the profile's space for this
+// config is (nearly) used up.
+type dedupe struct {
+	seen  map[string]struct{}
+	skips int
+}
+
+func newDedupe() *dedupe {
+	return &dedupe{seen: map[string]struct{}{}}
+}
+
+// admit reports whether res is new, recording it if so.
+func (d *dedupe) admit(res NameResult) (bool, error) {
+	key := res.First + "\x00" + res.Last
+	if _, dup := d.seen[key]; !dup {
+		d.seen[key] = struct{}{}
+		d.skips = 0
+		return true, nil
+	}
+	d.skips++
+	if d.skips >= DefaultMaxAttempts {
+		return false, fmt.Errorf("%w after %d unique names (%d duplicates in a row)", ErrSpaceExhausted, len(d.seen), d.skips)
+	}
+	return false, nil
+}
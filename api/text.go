@@ -0,0 +1,93 @@
+package api
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// A cases.Caser is stateful and must not be shared between goroutines, so
+// Title keeps a pool instead of each Generate call building its own.
+var titleCasers = sync.Pool{
+	New: func() any {
+		c := cases.Title(language.Und)
+		return &c
+	},
+}
+
+// Title title-cases s ("mARIA jose" -> "Maria Jose"). Safe for concurrent use.
+func Title(s string) string {
+	c := titleCasers.Get().(*cases.Caser)
+	defer titleCasers.Put(c)
+	return c.String(s)
+}
+
+// Chance reports true with probability pct/100.
+func Chance(r RandLike, pct int) bool {
+	return r.Intn(100) < pct
+}
+
+// Letters that do not decompose into a base letter and marks.
+var asciiLetters = strings.NewReplacer(
+	"ʻ", "", "ʼ", "", "’", "", "ʿ", "", "ʾ", "",
+	"đ", "d", "Đ", "D", "ı", "i", "ł", "l", "Ł", "L", "ø", "o", "Ø", "O",
+	"ß", "ss", "æ", "ae", "Æ", "Ae", "œ", "oe", "Œ", "Oe", "þ", "th", "Þ", "Th", "ð", "d", "Ð", "D",
+)
+
+// FoldASCII writes s without diacritics, tone marks or the ʻokina, keeping
+// case: "Nguyễn Thị" -> "Nguyen Thi", "Hawaiʻi" -> "Hawaii". Other non-ASCII
+// letters are left as they are.
+func FoldASCII(s string) string {
+	var b strings.Builder
+	for _, ch := range norm.NFD.String(asciiLetters.Replace(s)) {
+		if !unicode.Is(unicode.Mn, ch) {
+			b.WriteRune(ch)
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// foldResult applies FoldASCII to the Latin strings of res; Native keeps
+// its script.
+func foldResult(res NameResult) NameResult {
+	res.First, res.Last = FoldASCII(res.First), FoldASCII(res.Last)
+	if res.Parts == nil {
+		return res
+	}
+	parts := *res.Parts
+	for _, f := range []*string{&parts.SurnameBase, &parts.Father, &parts.Mother, &parts.Paternal, &parts.Maternal, &parts.Middle,
+		&parts.Kunya, &parts.Laqab, &parts.Nisba, &parts.Full} {
+		*f = FoldASCII(*f)
+	}
+	for _, list := range []*[]string{&parts.GivenNames, &parts.Surnames, &parts.Ancestors} {
+		if *list != nil {
+			folded := make([]string, len(*list))
+			for i, s := range *list {
+				folded[i] = FoldASCII(s)
+			}
+			*list = folded
+		}
+	}
+	res.Parts = &parts
+	return res
+}
+
+// foldForms applies FoldASCII to the forms of address and nicknames, which
+// api.Generate adds after the profile (Ông, Bé).
+func foldForms(res NameResult) NameResult {
+	for _, f := range []*string{&res.Title, &res.Suffix, &res.Address, &res.Formal} {
+		*f = FoldASCII(*f)
+	}
+	if res.Nicknames != nil {
+		folded := make([]string, len(res.Nicknames))
+		for i, s := range res.Nicknames {
+			folded[i] = FoldASCII(s)
+		}
+		res.Nicknames = folded
+	}
+	return res
+}
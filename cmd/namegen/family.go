@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/genealogy"
+)
+
+// writeFamily generates a genealogy.Tree and writes it as an indented tree
+// (text), as json records or as GEDCOM 5.5.1 (gedcom) or 7.0 (gedcom7).
+func writeFamily(w io.Writer, profile api.NameProfile, cfg api.ProfileConfig, opts genealogy.Options, format string) error {
+	tree, err := genealogy.Generate(profile, cfg, opts)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "", "text":
+		fmt.Fprintf(w, "%s family, %s inheritance (seed %d)\n", tree.Profile, tree.Inheritance, tree.Seed)
+		if len(tree.Families) == 0 {
+			return nil
+		}
+		writeBranch(w, tree, tree.Families[0], 0)
+		return nil
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tree)
+	case "gedcom":
+		return genealogy.WriteGEDCOM(w, tree, genealogy.GEDCOM551)
+	case "gedcom7":
+		return genealogy.WriteGEDCOM(w, tree, genealogy.GEDCOM70)
+	}
+	return fmt.Errorf("unknown format %q for family (want text, json, gedcom or gedcom7)", format)
+}
+
+// writeBranch prints a couple, then each child, recursing into the
+// families the children found.
+func writeBranch(w io.Writer, tree genealogy.Tree, f genealogy.Family, depth int) {
+	indent := strings.Repeat("    ", depth)
+	husband, _ := tree.Person(f.Husband)
+	wife, _ := tree.Person(f.Wife)
+	fmt.Fprintf(w, "%s%s = %s\n", indent, describe(husband), describe(wife))
+	for _, id := range f.Children {
+		child, _ := tree.Person(id)
+		if fam, ok := tree.Family(child.Family); ok && child.Family != f.ID {
+			writeBranch(w, tree, fam, depth+1)
+			continue
+		}
+		fmt.Fprintf(w, "%s    %s\n", indent, describe(child))
+	}
+}
+
+// describe formats one person, e.g. "I3 Jón Gunnarsson (m, 1926)".
+func describe(p genealogy.Person) string {
+	s := fmt.Sprintf("%s %s (%c, %d", p.ID, p.Name, p.Gender[0], p.BirthYear)
+	if p.MarriedSurname != "" {
+		s += ", married " + p.MarriedSurname
+	}
+	if p.NamedAfter != "" {
+		s += ", after " + p.NamedAfter
+	}
+	return s + ")"
+}
@@ -1,16 +1,26 @@
 package main
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/genealogy"
+	"github.com/nsa-yoda/namegen/identity"
 	_ "github.com/nsa-yoda/namegen/plugins/amharic"
 	_ "github.com/nsa-yoda/namegen/plugins/arabic"
 	_ "github.com/nsa-yoda/namegen/plugins/aramaic"
 	_ "github.com/nsa-yoda/namegen/plugins/baltic"
+	_ "github.com/nsa-yoda/namegen/plugins/bengali"
 	_ "github.com/nsa-yoda/namegen/plugins/celtic"
 	_ "github.com/nsa-yoda/namegen/plugins/chinese"
 	_ "github.com/nsa-yoda/namegen/plugins/english"
@@ -29,16 +39,22 @@ import (
 	_ "github.com/nsa-yoda/namegen/plugins/kazakh"
 	_ "github.com/nsa-yoda/namegen/plugins/korean"
 	_ "github.com/nsa-yoda/namegen/plugins/malay"
+	_ "github.com/nsa-yoda/namegen/plugins/malayalam"
 	_ "github.com/nsa-yoda/namegen/plugins/maori"
+	_ "github.com/nsa-yoda/namegen/plugins/marathi"
 	_ "github.com/nsa-yoda/namegen/plugins/nahuatl"
 	_ "github.com/nsa-yoda/namegen/plugins/nordic"
 	_ "github.com/nsa-yoda/namegen/plugins/portuguese"
+	_ "github.com/nsa-yoda/namegen/plugins/punjabi"
 	_ "github.com/nsa-yoda/namegen/plugins/samoan"
 	_ "github.com/nsa-yoda/namegen/plugins/slavic"
 	_ "github.com/nsa-yoda/namegen/plugins/spanish"
 	_ "github.com/nsa-yoda/namegen/plugins/swahili"
+	_ "github.com/nsa-yoda/namegen/plugins/tahitian"
 	_ "github.com/nsa-yoda/namegen/plugins/tamil"
+	_ "github.com/nsa-yoda/namegen/plugins/telugu"
 	_ "github.com/nsa-yoda/namegen/plugins/thai"
+	_ "github.com/nsa-yoda/namegen/plugins/tongan"
 	_ "github.com/nsa-yoda/namegen/plugins/turkish"
 	_ "github.com/nsa-yoda/namegen/plugins/uzbek"
 	_ "github.com/nsa-yoda/namegen/plugins/vietnamese"
@@ -49,77 +65,236 @@ import (
 const defaultFallbackGenerator = "english"
 
 func main() {
+	// Subcommands share the generation flags, e.g. `namegen stats -mode japanese -l`.
+	command, args := "", os.Args[1:]
+	if len(args) > 0 && (args[0] == "stats" || args[0] == "family") {
+		command, args = args[0], args[1:]
+	}
+
 	// CLI flags
 	mode := flag.String("mode", "english", "Mode/profile name (compiled-in). If not found, English is used by default.")
 	includeLast := flag.Bool("l", false, "Include last name")
 	reverse := flag.Bool("r", false, "Reverse order (last first)")
 	gender := flag.String("gender", "neutral", "Gender: male|female|neutral")
 	family := flag.String("family", "", "Family override for surname rules (e.g., japan, nordic, spanish)")
+	convention := flag.String("convention", "", "Naming convention within the profile: surname|double|joined|patronymic|matronymic|chain|classical|hebraized (profile default if empty)")
+	compound := flag.Int("compound", 0, "Percent chance of compound given names such as Jose Luis (0 profile default, -1 never)")
+	depth := flag.Int("depth", 0, "Ancestors named in chain and classical names, e.g. 2 for father and grandfather (0 profile default)")
+	script := flag.String("script", api.ScriptLatin, "Script: latin|native, or a profile's own script such as katakana, cyrillic or syriac (also prints the name in that script, e.g. Hanzi)")
+	romanization := flag.String("romanization", "", "Romanization system, profile-specific (chinese: pinyin|pinyin-tones|wade-giles|jyutping; korean: passport|revised|mccune-reischauer; japanese: hepburn|hepburn-macrons|kunrei|nihon-shiki)")
+	ascii := flag.Bool("ascii", false, "Fold names to plain ASCII: no diacritics, tone marks or ʻokina")
 	realism := flag.Int("realism", 50, "Realism 0..100 (0 fictional phonotactics, 100 real-looking names)")
-	seed := flag.Int64("s", 0, "Seed (0 or omit for random)")
+	seed := flag.String("s", "", "Seed: an integer or any string such as npc:guard:17 (0 or omit for random)")
 	count := flag.Int("c", 1, "Number of names to generate, 1 by default or omitted")
 	listProfiles := flag.Bool("p", false, "Show available profiles")
 	devMode := flag.Bool("d", false, "Development mode")
-	flag.Parse()
+	unique := flag.Bool("unique", false, "Never print the same name twice in one run")
+	format := flag.String("format", "text", "Output format: text|json|csv (json/csv records carry seeds); family also takes gedcom|gedcom7")
+	printSeed := flag.Bool("print-seed", false, "Print the effective seed and a replay token to stderr")
+	replay := flag.String("replay", "", "Replay token from -print-seed/-d; overrides generation flags")
+	titles := flag.Bool("titles", false, "Add a culture-appropriate title/honorific (Dr., Doña, -san, Sheikh, Chief...)")
+	suffixes := flag.Bool("suffixes", false, "Sometimes add a generational suffix (Jr., III, Filho...); needs -l")
+	nicknames := flag.Bool("nicknames", false, "Add nicknames/diminutives of each first name")
+	workers := flag.Int("workers", runtime.NumCPU(), "Number of generator goroutines (output order does not depend on it)")
+
+	// Constraint flags
+	minLen := flag.Int("minlen", 0, "Minimum first name length in letters (0 for no limit)")
+	maxLen := flag.Int("maxlen", 0, "Maximum first name length in letters (0 for no limit)")
+	prefix := flag.String("prefix", "", "First name must start with this (case-insensitive)")
+	suffix := flag.String("suffix", "", "First name must end with this (case-insensitive)")
+	contains := flag.String("contains", "", "First name must match this regex (case-insensitive)")
+	excludes := flag.String("excludes", "", "First name must not match this regex (case-insensitive)")
+	syllables := flag.Int("syllables", 0, "Exact number of syllables in the first name (0 for any)")
+	initials := flag.String("initials", "", "Required initials, e.g. A.B. (second letter needs -l)")
+
+	// Filter flags
+	profanity := flag.Bool("profanity-filter", true, "Reject names containing profanity (built-in multilingual list)")
+	blocklist := flag.String("blocklist", "", "Path to a blocklist file (one entry per line, # comments)")
+	avoidFamous := flag.Bool("avoid-famous", false, "Reject first+last combinations matching famous real people")
+
+	// Identity flags
+	withIdentity := flag.Bool("identity", false, "Add username, e-mail, handle, initials, honorific and birthdate to each name")
+	domains := flag.String("domains", "", "Comma-separated e-mail domains (reserved example domains only; default example.com,example.net,example.org)")
+	ageMin := flag.Int("age-min", identity.DefaultAge.Min, "identity: minimum age in years")
+	ageMax := flag.Int("age-max", identity.DefaultAge.Max, "identity: maximum age in years")
+	ageMean := flag.Float64("age-mean", identity.DefaultAge.Mean, "identity: mean age in years")
+	ageSD := flag.Float64("age-sd", identity.DefaultAge.StdDev, "identity: age standard deviation in years (0 = always the mean)")
+	asOf := flag.String("asof", "", "identity: date ages are computed at, YYYY-MM-DD (default 2026-01-01)")
+
+	// stats flags
+	population := flag.Int("n", 100000, "stats: number of names you plan to generate (collision estimate)")
+	samples := flag.Int("samples", api.DefaultSpaceSamples, "stats: number of names to sample")
+
+	// family flags
+	generations := flag.Int("generations", 3, "family: number of generations, founders included")
+	childrenMin := flag.Int("children-min", 1, "family: minimum children per couple")
+	childrenMax := flag.Int("children-max", 4, "family: maximum children per couple")
+	namesake := flag.Int("namesake", 30, "family: percent chance a child is named after a grandparent (-1 for never)")
+	startYear := flag.Int("start-year", 1900, "family: founders' approximate birth year")
+	inheritance := flag.String("inheritance", "", "family: surname|double|double-maternal|patronymic|matronymic|chain (profile default if empty)")
+	if err := flag.CommandLine.Parse(args); err != nil {
+		log.Fatal(err)
+	}
 
 	cfg := api.ProfileConfig{
-		Count:       *count,
-		Mode:        *mode,
-		Seed:        *seed,
-		Realism:     *realism,
-		Gender:      *gender,
-		Family:      *family,
-		IncludeLast: *includeLast,
-		Reverse:     *reverse,
-		DevMode:     *devMode,
+		Count:        *count,
+		Mode:         *mode,
+		Seed:         api.ResolveSeed(api.ParseSeed(*seed)),
+		Realism:      *realism,
+		Gender:       *gender,
+		Family:       *family,
+		Convention:   *convention,
+		Compound:     *compound,
+		Depth:        *depth,
+		Script:       *script,
+		Romanization: *romanization,
+		ASCII:        *ascii,
+		IncludeLast:  *includeLast,
+		Reverse:      *reverse,
+		DevMode:      *devMode,
+		Unique:       *unique,
+		Titles:       *titles,
+		Suffixes:     *suffixes,
+		Nicknames:    *nicknames,
+		Constraints: api.Constraints{
+			MinLen:    *minLen,
+			MaxLen:    *maxLen,
+			Prefix:    *prefix,
+			Suffix:    *suffix,
+			Contains:  *contains,
+			Excludes:  *excludes,
+			Syllables: *syllables,
+			Initials:  *initials,
+		},
 	}
 
-	if *listProfiles {
-		for _, p := range api.ListProfiles() {
-			fmt.Println(p)
+	if *replay != "" {
+		replayed, err := api.ParseReplayToken(*replay)
+		if replayed.Mode == "" {
+			log.Fatalf("bad replay token: %v", err)
 		}
-		return
+		if err != nil {
+			log.Printf("warning: %v", err)
+		}
+		cfg = replayed
 	}
 
-	if *devMode {
-		b, err := json.MarshalIndent(cfg, "", "  ")
+	if *profanity {
+		cfg.Filters = append(cfg.Filters, api.ProfanityFilter())
+	}
+	if *blocklist != "" {
+		f, err := api.LoadBlocklist(*blocklist)
 		if err != nil {
-			log.Printf("devMode: could not marshal config: %v\n", err)
-		} else {
-			fmt.Printf("Dev Mode Active - Config:\n%s\n", string(b))
+			log.Fatalf("could not load blocklist: %v", err)
 		}
+		cfg.Filters = append(cfg.Filters, f)
+	}
+	if *avoidFamous {
+		cfg.Filters = append(cfg.Filters, api.FamousNamesFilter())
+	}
+
+	if *listProfiles {
+		for _, p := range api.ListProfiles() {
+			fmt.Println(p)
+		}
+		return
 	}
 
 	// Load our chosen profile (compiled-in registry)
-	profile, err := api.GetProfile(*mode)
+	profile, err := api.GetProfile(cfg.Mode)
 	if err != nil {
-		log.Printf("profile not found for mode %q — using builtin fallback %q\n", *mode, defaultFallbackGenerator)
+		log.Printf("profile not found for mode %q — using builtin fallback %q\n", cfg.Mode, defaultFallbackGenerator)
 		profile, err = api.GetProfile(defaultFallbackGenerator)
 		if err != nil {
 			log.Fatalf("builtin fallback profile not found for mode %q\n", defaultFallbackGenerator)
 		}
+		cfg.Mode = defaultFallbackGenerator
 	}
+	if scripts := api.Scripts(profile); cfg.Script != "" && cfg.Script != api.ScriptLatin && cfg.Script != api.ScriptNative && !slices.Contains(scripts, cfg.Script) {
+		log.Fatalf("unknown -script %q for %s (want %s)", cfg.Script, cfg.Mode, strings.Join(append([]string{api.ScriptLatin, api.ScriptNative}, scripts...), ", "))
+	}
+	if systems := api.Romanizations(profile); cfg.Romanization != "" && !slices.Contains(systems, cfg.Romanization) {
+		if len(systems) == 0 {
+			log.Fatalf("profile %q has no -romanization options", cfg.Mode)
+		}
+		log.Fatalf("unknown -romanization %q for %s (want %s)", cfg.Romanization, cfg.Mode, strings.Join(systems, ", "))
+	}
+	gen := api.NewGenerator(profile, cfg)
 
-	n := cfg.Count
-	if n <= 0 {
-		n = 1
+	if *printSeed {
+		fmt.Fprintf(os.Stderr, "seed: %d\nreplay: %s\n", gen.Seed(), gen.ReplayToken())
 	}
 
-	for i := 0; i < n; i++ {
-		// Generate and print
-		res, err := profile.Generate(cfg)
+	if *devMode {
+		b, err := json.MarshalIndent(struct {
+			api.ProfileConfig
+			AlgorithmVersion int    `json:"algorithmVersion"`
+			Replay           string `json:"replay"`
+		}{gen.Config(), api.AlgorithmVersion, gen.ReplayToken()}, "", "  ")
 		if err != nil {
-			log.Fatalf("generate failed: %v", err)
+			log.Printf("devMode: could not marshal config: %v\n", err)
+		} else {
+			fmt.Printf("Dev Mode Active - Config:\n%s\n", string(b))
 		}
+	}
+
+	if command == "stats" {
+		printStats(profile, cfg, *population, *samples)
+		return
+	}
 
-		if cfg.IncludeLast {
-			if cfg.Reverse {
-				fmt.Printf("%s %s\n", res.Last, res.First)
-			} else {
-				fmt.Printf("%s %s\n", res.First, res.Last)
+	if command == "family" {
+		out := bufio.NewWriter(os.Stdout)
+		opts := genealogy.Options{
+			Generations: *generations,
+			MinChildren: *childrenMin,
+			MaxChildren: *childrenMax,
+			NamesakePct: *namesake,
+			StartYear:   *startYear,
+			Inheritance: genealogy.Inheritance(*inheritance),
+		}
+		if err := writeFamily(out, profile, gen.Config(), opts, *format); err != nil {
+			log.Fatalf("family failed: %v", err)
+		}
+		if err := out.Flush(); err != nil {
+			log.Fatalf("write failed: %v", err)
+		}
+		return
+	}
+
+	var ids *identity.Options
+	if *withIdentity {
+		ids = &identity.Options{
+			Age: identity.AgeDistribution{Min: *ageMin, Max: *ageMax, Mean: *ageMean, StdDev: *ageSD},
+		}
+		for _, d := range strings.Split(*domains, ",") {
+			if d = strings.TrimSpace(d); d != "" {
+				ids.Domains = append(ids.Domains, d)
+			}
+		}
+		if *asOf != "" {
+			ids.AsOf, err = time.Parse(time.DateOnly, *asOf)
+			if err != nil {
+				log.Fatalf("bad -asof date: %v", err)
 			}
-		} else {
-			fmt.Println(res.First)
 		}
+		// Validate domains and ages once, before any output is written.
+		if _, err := identity.New(api.NameResult{}, cfg, *ids); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Generate on a worker pool; names come back in order and are written
+	// through one buffered writer instead of a Printf per line.
+	out := bufio.NewWriterSize(os.Stdout, 64*1024)
+	write, err := newWriter(out, *format, gen.Config(), ids)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := gen.Batch(context.Background(), *workers, write); err != nil {
+		log.Fatalf("generate failed: %v", err)
+	}
+	if err := out.Flush(); err != nil {
+		log.Fatalf("write failed: %v", err)
 	}
 }
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/identity"
+)
+
+// record is one structured (json/csv) output line.
+type record struct {
+	Index   int    `json:"index"`
+	First   string `json:"first"`
+	Last    string `json:"last,omitempty"`
+	Seed    int64  `json:"seed"`    // replays this name: -s <seed> -c 1 (0 for a grafted name)
+	RunSeed int64  `json:"runSeed"` // replays the whole run: -s <runSeed>
+
+	// Forms of address, with -titles / -suffixes.
+	Title   string `json:"title,omitempty"`
+	Suffix  string `json:"suffix,omitempty"`
+	Address string `json:"address,omitempty"`
+	Formal  string `json:"formal,omitempty"`
+
+	Nicknames []string `json:"nicknames,omitempty"` // with -nicknames
+
+	Parts  *api.NameParts `json:"parts,omitempty"`  // structured name, for profiles that provide one
+	Native string         `json:"native,omitempty"` // with -script native
+
+	Identity *identity.Identity `json:"identity,omitempty"` // set with -identity
+}
+
+// identityColumns extend the csv header when -identity is set.
+var identityColumns = []string{
+	"full_name", "sort_name", "initials", "honorific", "username", "handle", "email", "birthdate", "age",
+}
+
+// newWriter returns the Batch emit function for the given output format.
+// A non-nil ids adds an identity.Identity to every record.
+func newWriter(w io.Writer, format string, cfg api.ProfileConfig, ids *identity.Options) (func(int, api.NameResult) error, error) {
+	derive := func(res api.NameResult) (*identity.Identity, error) {
+		if ids == nil {
+			return nil, nil
+		}
+		id, err := identity.New(res, cfg, *ids)
+		return &id, err
+	}
+
+	switch format {
+	case "", "text":
+		return func(_ int, res api.NameResult) error {
+			line := res.First
+			if cfg.IncludeLast {
+				if cfg.Reverse {
+					line = api.OrderedName(res, true)
+				} else if res.Parts != nil && res.Parts.Full != "" {
+					line = res.Parts.Full
+				} else {
+					line = res.First + " " + res.Last
+				}
+			}
+			if res.Formal != "" {
+				line = res.Formal
+			}
+			if res.Native != "" {
+				line = res.Native + " (" + line + ")"
+			}
+			if len(res.Nicknames) > 0 {
+				line += " (" + strings.Join(res.Nicknames, ", ") + ")"
+			}
+			id, err := derive(res)
+			if err != nil {
+				return err
+			}
+			if id != nil {
+				name := id.Honorific + " " + id.FullName
+				if res.Formal != "" {
+					name = res.Formal
+				}
+				line = fmt.Sprintf("%s\t%s\t%s\t%s\t%s", name, id.Username, id.Email, id.Handle, id.Birthdate)
+			}
+			_, err = io.WriteString(w, line+"\n")
+			return err
+		}, nil
+
+	case "json":
+		enc := json.NewEncoder(w)
+		return func(i int, res api.NameResult) error {
+			id, err := derive(res)
+			if err != nil {
+				return err
+			}
+			return enc.Encode(record{Index: i, First: res.First, Last: res.Last, Seed: res.Seed, RunSeed: cfg.Seed,
+				Title: res.Title, Suffix: res.Suffix, Address: res.Address, Formal: res.Formal,
+				Nicknames: res.Nicknames, Parts: res.Parts, Native: res.Native, Identity: id})
+		}, nil
+
+	case "csv":
+		forms := cfg.Titles || cfg.Suffixes
+		native := cfg.Script != "" && cfg.Script != api.ScriptLatin
+		cw := csv.NewWriter(w)
+		header := false
+		return func(i int, res api.NameResult) error {
+			if !header {
+				header = true
+				cols := []string{"index", "first", "last", "seed", "run_seed"}
+				if forms {
+					cols = append(cols, "title", "suffix", "address", "formal")
+				}
+				if cfg.Nicknames {
+					cols = append(cols, "nicknames")
+				}
+				if native {
+					cols = append(cols, "native")
+				}
+				if ids != nil {
+					cols = append(cols, identityColumns...)
+				}
+				if err := cw.Write(cols); err != nil {
+					return err
+				}
+			}
+			row := []string{
+				strconv.Itoa(i), res.First, res.Last,
+				strconv.FormatInt(res.Seed, 10), strconv.FormatInt(cfg.Seed, 10),
+			}
+			if forms {
+				row = append(row, res.Title, res.Suffix, res.Address, res.Formal)
+			}
+			if cfg.Nicknames {
+				row = append(row, strings.Join(res.Nicknames, ";"))
+			}
+			if native {
+				row = append(row, res.Native)
+			}
+			id, err := derive(res)
+			if err != nil {
+				return err
+			}
+			if id != nil {
+				row = append(row, id.FullName, id.SortName, id.Initials, id.Honorific,
+					id.Username, id.Handle, id.Email, id.Birthdate, strconv.Itoa(id.Age))
+			}
+			err = cw.Write(row)
+			cw.Flush()
+			if err != nil {
+				return err
+			}
+			return cw.Error()
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown -format %q (want text, json or csv)", format)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// printStats runs api.EstimateSpaceSamples and prints per-component
+// cardinality plus the collision estimate for population names.
+func printStats(profile api.NameProfile, cfg api.ProfileConfig, population, samples int) {
+	sp, err := api.EstimateSpaceSamples(profile, cfg, samples)
+	if err != nil {
+		log.Fatalf("stats failed: %v", err)
+	}
+
+	fmt.Printf("profile: %s (gender %s, realism %d, %d samples)\n\n", sp.Profile, cfg.Gender, cfg.Realism, sp.Samples)
+	fmt.Printf("%-6s %12s %12s %12s %10s %12s %12s %8s\n", "part", "curated", "procedural", "total", "observed", "chao1", "effective", "real%")
+
+	type row struct {
+		name string
+		cs   api.ComponentSpace
+	}
+	rows := []row{{"first", sp.First}}
+	if cfg.IncludeLast {
+		rows = append(rows, row{"last", sp.Last}, row{"full", sp.Full})
+	}
+	for _, row := range rows {
+		cs := row.cs
+		fmt.Printf("%-6s %12.4g %12.4g %12.4g %10d %12.4g %12.4g %7.1f%%\n",
+			row.name, cs.Curated, cs.Procedural, cs.Total(), cs.Observed, cs.Chao1, cs.Effective, cs.RealShare*100)
+	}
+
+	fmt.Printf("\nfor %d names: P(any duplicate) = %.4g, expected duplicate pairs = %.4g\n",
+		population, sp.Full.CollisionProbability(population), sp.Full.ExpectedCollisions(population))
+}
@@ -0,0 +1,388 @@
+package genealogy
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// GEDCOM versions WriteGEDCOM can produce.
+const (
+	GEDCOM551 = "5.5.1"
+	GEDCOM70  = "7.0"
+)
+
+var ErrGEDCOM = errors.New("genealogy: malformed GEDCOM")
+
+var gedcomMonths = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+// WriteGEDCOM writes t as a GEDCOM file (version GEDCOM551 or GEDCOM70; ""
+// means 5.5.1): an INDI record per person with NAME ("Given /Surname/",
+// plus a married NAME where there is one), GIVN/SURN/NPFX/NSFX, SEX and a
+// synthetic BIRT date, and a FAM record per couple. A middle name is part
+// of the given names (GIVN "Thị Phương") and repeated in an _MIDN
+// extension tag so ReadGEDCOM can split it off again.
+//
+// Cross-reference IDs embed a prefix derived from t.Seed ("@I3FA21C_1@"),
+// so files from different seeds can be merged without clashes and the same
+// seed always gives the same file.
+func WriteGEDCOM(w io.Writer, t Tree, version string) error {
+	switch version {
+	case "":
+		version = GEDCOM551
+	case GEDCOM551, GEDCOM70:
+	default:
+		return fmt.Errorf("genealogy: unsupported GEDCOM version %q", version)
+	}
+	v7 := version == GEDCOM70
+	prefix := xrefPrefix(t.Seed)
+	xref := func(id string) string { return "@" + id[:1] + prefix + "_" + id[1:] + "@" }
+	order := func(p Person) bool { return p.Surname != "" && p.Name == joinNonEmpty(p.Surname, p.Middle, p.Given) }
+
+	bw := bufio.NewWriter(w)
+	line := func(level int, tag, value string) {
+		if value == "" {
+			fmt.Fprintf(bw, "%d %s\n", level, tag)
+			return
+		}
+		fmt.Fprintf(bw, "%d %s %s\n", level, tag, value)
+	}
+
+	line(0, "HEAD", "")
+	line(1, "GEDC", "")
+	line(2, "VERS", version)
+	if !v7 {
+		line(2, "FORM", "LINEAGE-LINKED")
+		line(1, "CHAR", "UTF-8")
+	}
+	line(1, "SOUR", "NAMEGEN")
+	line(2, "NAME", "namegen")
+	if !v7 {
+		line(1, "SUBM", "@U1@") // required by 5.5.1
+	}
+	line(1, "NOTE", fmt.Sprintf("namegen profile=%s seed=%d inheritance=%s", t.Profile, t.Seed, t.Inheritance))
+	if !v7 {
+		line(0, "@U1@ SUBM", "")
+		line(1, "NAME", "namegen")
+	}
+
+	for _, p := range t.People {
+		fmt.Fprintf(bw, "0 %s INDI\n", xref(p.ID))
+		writeName(line, p, p.Surname, order(p), "")
+		if p.MarriedSurname != "" {
+			typ := "married"
+			if v7 {
+				typ = "MARRIED"
+			}
+			writeName(line, p, p.MarriedSurname, order(p), typ)
+		}
+		line(1, "SEX", gedcomSex(p.Gender))
+		line(1, "BIRT", "")
+		line(2, "DATE", birthDate(t.Seed, p))
+		if p.Father != "" {
+			if f, ok := parentFamily(t, p); ok {
+				line(1, "FAMC", xref(f.ID))
+			}
+		}
+		if p.Family != "" {
+			line(1, "FAMS", xref(p.Family))
+		}
+	}
+
+	for _, f := range t.Families {
+		fmt.Fprintf(bw, "0 %s FAM\n", xref(f.ID))
+		line(1, "HUSB", xref(f.Husband))
+		line(1, "WIFE", xref(f.Wife))
+		for _, c := range f.Children {
+			line(1, "CHIL", xref(c))
+		}
+	}
+	line(0, "TRLR", "")
+	return bw.Flush()
+}
+
+// writeName writes a NAME structure. familyFirst puts the surname first
+// ("/Tanaka/ Yui"), as GEDCOM allows.
+func writeName(line func(int, string, string), p Person, surname string, familyFirst bool, typ string) {
+	given := givenNames(p, familyFirst)
+	value := given
+	switch {
+	case surname == "":
+	case familyFirst:
+		value = "/" + surname + "/ " + given
+	default:
+		value = given + " /" + surname + "/"
+	}
+	if p.Title != "" {
+		value = p.Title + " " + value
+	}
+	if p.Suffix != "" {
+		value += " " + p.Suffix
+	}
+	line(1, "NAME", value)
+	if typ != "" {
+		line(2, "TYPE", typ)
+	}
+	for _, piece := range [][2]string{{"NPFX", p.Title}, {"GIVN", given}, {"_MIDN", p.Middle}, {"SURN", surname}, {"NSFX", p.Suffix}} {
+		if piece[1] != "" {
+			line(2, piece[0], piece[1])
+		}
+	}
+}
+
+// ReadGEDCOM parses a file written by WriteGEDCOM back into a Tree. People,
+// families, names (middle names included), titles, sexes, birth years and
+// the profile/seed header round-trip; Parts and NamedAfter are not stored in GEDCOM. Generations
+// are recomputed from the family links.
+func ReadGEDCOM(r io.Reader) (Tree, error) {
+	var t Tree
+	var person *Person
+	var family *Family
+	parent := ""                   // last level-1 tag, for level-2 lines
+	names, nameType := 0, ""       // NAME structures seen for person, and the current one's TYPE
+	ids := map[string]string{}     // xref -> tree ID
+	famc := map[string]string{}    // person ID -> family ID
+	rawName := map[string]string{} // person ID -> first NAME value
+	id := func(xref string) string {
+		if v, ok := ids[xref]; ok {
+			return v
+		}
+		v := strings.Trim(xref, "@")
+		if i := strings.LastIndexByte(v, '_'); i > 0 {
+			v = v[:1] + v[i+1:]
+		}
+		ids[xref] = v
+		return v
+	}
+	flush := func() {
+		if person != nil {
+			t.People = append(t.People, *person)
+			person = nil
+		}
+		if family != nil {
+			t.Families = append(t.Families, *family)
+			family = nil
+		}
+	}
+
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		text := strings.TrimRight(strings.TrimPrefix(sc.Text(), "\uFEFF"), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 3)
+		level, err := strconv.Atoi(fields[0])
+		if err != nil || len(fields) < 2 {
+			return Tree{}, fmt.Errorf("%w: line %d: %q", ErrGEDCOM, n, text)
+		}
+		tag, value := fields[1], ""
+		if len(fields) == 3 {
+			value = fields[2]
+		}
+
+		switch level {
+		case 0:
+			flush()
+			names = 0
+			if strings.HasPrefix(tag, "@") {
+				switch value {
+				case "INDI":
+					person = &Person{ID: id(tag)}
+				case "FAM":
+					family = &Family{ID: id(tag)}
+				}
+			}
+			continue
+		case 1:
+			parent = tag
+			if tag == "NAME" {
+				names++
+				nameType = ""
+			}
+		}
+
+		switch {
+		case person != nil && level == 1:
+			switch tag {
+			case "NAME":
+				if names == 1 {
+					rawName[person.ID] = value
+				}
+			case "SEX":
+				person.Gender = map[string]string{"M": "male", "F": "female"}[value]
+			case "FAMC":
+				famc[person.ID] = id(value)
+			case "FAMS":
+				person.Family = id(value)
+			}
+		case person != nil && level == 2 && parent == "NAME":
+			switch {
+			case tag == "TYPE":
+				nameType = strings.ToLower(value)
+			case tag == "SURN" && names > 1 && nameType == "married":
+				person.MarriedSurname = value
+			case names > 1:
+			case tag == "SURN":
+				person.Surname = value
+			case tag == "GIVN":
+				person.Given = value
+			case tag == "_MIDN":
+				person.Middle = value
+			case tag == "NPFX":
+				person.Title = value
+			case tag == "NSFX":
+				person.Suffix = value
+			}
+		case person != nil && level == 2 && parent == "BIRT" && tag == "DATE":
+			if f := strings.Fields(value); len(f) > 0 {
+				person.BirthYear, _ = strconv.Atoi(f[len(f)-1])
+			}
+		case family != nil && level == 1:
+			switch tag {
+			case "HUSB":
+				family.Husband = id(value)
+			case "WIFE":
+				family.Wife = id(value)
+			case "CHIL":
+				family.Children = append(family.Children, id(value))
+			}
+		case level == 1 && tag == "NOTE" && strings.HasPrefix(value, "namegen "):
+			for _, kv := range strings.Fields(value)[1:] {
+				k, v, _ := strings.Cut(kv, "=")
+				switch k {
+				case "profile":
+					t.Profile = v
+				case "seed":
+					t.Seed, _ = strconv.ParseInt(v, 10, 64)
+				case "inheritance":
+					t.Inheritance = Inheritance(v)
+				}
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return Tree{}, err
+	}
+	flush()
+
+	// Rebuild the links GEDCOM keeps on the family records.
+	index := map[string]int{}
+	for i, p := range t.People {
+		index[p.ID] = i
+	}
+	for _, f := range t.Families {
+		if h, ok := index[f.Husband]; ok {
+			t.People[h].Spouse = f.Wife
+		}
+		if w, ok := index[f.Wife]; ok {
+			t.People[w].Spouse = f.Husband
+		}
+	}
+	fams := map[string]Family{}
+	for _, f := range t.Families {
+		fams[f.ID] = f
+	}
+	for i := range t.People {
+		p := &t.People[i]
+		if f, ok := fams[famc[p.ID]]; ok {
+			p.Father, p.Mother = f.Husband, f.Wife
+		}
+		famFirst := p.Surname != "" && surnameFirst(rawName[p.ID], p.Title)
+		if p.Middle != "" {
+			// GIVN holds the middle name too, where the NAME has it.
+			if famFirst {
+				p.Given = strings.TrimPrefix(p.Given, p.Middle+" ")
+			} else {
+				p.Given = strings.TrimSuffix(p.Given, " "+p.Middle)
+			}
+		}
+		if famFirst {
+			p.Name = joinNonEmpty(p.Surname, p.Middle, p.Given)
+		} else {
+			p.Name = joinNonEmpty(p.Given, p.Middle, p.Surname)
+		}
+	}
+	setGenerations(t.People, index)
+	return t, nil
+}
+
+// givenNames is p's given name with the middle name on the side of it the
+// surname is on: "Thị Phương" family name first, "Phương Thị" otherwise.
+func givenNames(p Person, familyFirst bool) string {
+	if familyFirst {
+		return joinNonEmpty(p.Middle, p.Given)
+	}
+	return joinNonEmpty(p.Given, p.Middle)
+}
+
+// surnameFirst reports whether a NAME value puts the /surname/ before the
+// given name, i.e. only the title precedes it.
+func surnameFirst(name, title string) bool {
+	before, _, ok := strings.Cut(name, "/")
+	return ok && strings.TrimSpace(before) == title
+}
+
+// setGenerations numbers generations from the family links: children are
+// one below their parents, spouses share a generation.
+func setGenerations(people []Person, index map[string]int) {
+	var gen func(i int, seen map[int]bool) int
+	gen = func(i int, seen map[int]bool) int {
+		if seen[i] {
+			return 0
+		}
+		seen[i] = true
+		p := people[i]
+		if f, ok := index[p.Father]; ok {
+			return gen(f, seen) + 1
+		}
+		if s, ok := index[p.Spouse]; ok {
+			if sp := people[s]; sp.Father != "" {
+				return gen(s, seen)
+			}
+		}
+		return 0
+	}
+	for i := range people {
+		people[i].Generation = gen(i, map[int]bool{})
+	}
+}
+
+func parentFamily(t Tree, p Person) (Family, bool) {
+	for _, f := range t.Families {
+		if f.Husband == p.Father && f.Wife == p.Mother {
+			return f, true
+		}
+	}
+	return Family{}, false
+}
+
+// xrefPrefix is six hex digits derived from the seed.
+func xrefPrefix(seed int64) string {
+	return fmt.Sprintf("%06X", uint64(api.DeriveSeed(seed, "gedcom"))>>40)
+}
+
+// birthDate is a synthetic GEDCOM date ("14 MAR 1903") in p's birth year,
+// fixed by the seed and p's ID.
+func birthDate(seed int64, p Person) string {
+	r := rand.New(rand.NewSource(api.DeriveSeed(seed, "gedcom", p.ID)))
+	month := r.Intn(12)
+	days := []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}[month]
+	return fmt.Sprintf("%d %s %d", 1+r.Intn(days), gedcomMonths[month], p.BirthYear)
+}
+
+func gedcomSex(gender string) string {
+	switch gender {
+	case "male":
+		return "M"
+	case "female":
+		return "F"
+	}
+	return "U"
+}
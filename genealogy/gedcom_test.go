@@ -0,0 +1,93 @@
+package genealogy_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/genealogy"
+	_ "github.com/nsa-yoda/namegen/plugins/amharic"
+	_ "github.com/nsa-yoda/namegen/plugins/arabic"
+	_ "github.com/nsa-yoda/namegen/plugins/english"
+	_ "github.com/nsa-yoda/namegen/plugins/hebrew"
+	_ "github.com/nsa-yoda/namegen/plugins/japanese"
+	_ "github.com/nsa-yoda/namegen/plugins/nordic"
+	_ "github.com/nsa-yoda/namegen/plugins/portuguese"
+	_ "github.com/nsa-yoda/namegen/plugins/slavic"
+	_ "github.com/nsa-yoda/namegen/plugins/spanish"
+	_ "github.com/nsa-yoda/namegen/plugins/vietnamese"
+)
+
+// TestGEDCOMRoundTrip writes trees in both GEDCOM versions and parses them
+// back: everything but Parts and NamedAfter must survive.
+func TestGEDCOMRoundTrip(t *testing.T) {
+	cases := []struct{ mode, convention string }{
+		{"english", ""}, {"spanish", ""}, {"portuguese", ""}, {"nordic", ""}, {"nordic", "patronymic"},
+		{"slavic", ""}, {"japanese", ""}, {"vietnamese", ""}, {"arabic", ""}, {"amharic", ""}, {"hebrew", ""},
+	}
+	for _, c := range cases {
+		p, err := api.GetProfile(c.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, version := range []string{genealogy.GEDCOM551, genealogy.GEDCOM70} {
+			t.Run(c.mode+"/"+c.convention+"/"+version, func(t *testing.T) {
+				for seed := int64(1); seed <= 5; seed++ {
+					cfg := api.ProfileConfig{Mode: c.mode, Convention: c.convention, Seed: seed, Realism: 80, Titles: true, Suffixes: true}
+					tree, err := genealogy.Generate(p, cfg, genealogy.Options{Generations: 4})
+					if err != nil {
+						t.Fatal(err)
+					}
+					var buf bytes.Buffer
+					if err := genealogy.WriteGEDCOM(&buf, tree, version); err != nil {
+						t.Fatal(err)
+					}
+					if !strings.Contains(buf.String(), "2 VERS "+version+"\n") {
+						t.Fatalf("seed %d: header does not name version %s", seed, version)
+					}
+					got, err := genealogy.ReadGEDCOM(&buf)
+					if err != nil {
+						t.Fatalf("seed %d: %v", seed, err)
+					}
+					want := tree
+					want.People = append([]genealogy.Person(nil), tree.People...)
+					for i := range want.People {
+						want.People[i].Parts = nil
+						want.People[i].NamedAfter = ""
+					}
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("seed %d: round trip differs\n got %+v\nwant %+v", seed, got, want)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestWriteGEDCOMVersion(t *testing.T) {
+	p, _ := api.GetProfile("english")
+	tree, err := genealogy.Generate(p, api.ProfileConfig{Mode: "english", Seed: 1}, genealogy.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := genealogy.WriteGEDCOM(&buf, tree, "5.5"); err == nil {
+		t.Error("WriteGEDCOM accepted version 5.5")
+	}
+	if err := genealogy.WriteGEDCOM(&buf, tree, ""); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "2 VERS 5.5.1\n") {
+		t.Error("empty version did not default to 5.5.1")
+	}
+}
+
+func TestReadGEDCOMMalformed(t *testing.T) {
+	for _, in := range []string{"HEAD\n", "x INDI\n", "0\n"} {
+		if _, err := genealogy.ReadGEDCOM(strings.NewReader(in)); err == nil {
+			t.Errorf("ReadGEDCOM(%q) succeeded", in)
+		}
+	}
+}
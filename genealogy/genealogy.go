@@ -0,0 +1,555 @@
+// Package genealogy generates family trees whose names follow each
+// culture's inheritance rules: fixed family names passed from father to
+// child (English, Japanese, Korean, Vietnamese clan names), Spanish and
+// Portuguese double surnames, Icelandic patronymics, Amharic and Arabic
+// name chains (given + father + grandfather), Slavic and Baltic gender
+// inflection, and children named after their grandparents.
+//
+// A tree is fully determined by the profile, the config (including its
+// seed) and the options.
+//
+// Usage:
+//
+//	p, _ := api.GetProfile("slavic")
+//	tree, err := genealogy.Generate(p, api.ProfileConfig{Seed: 42, Family: "polish"}, genealogy.Options{})
+package genealogy
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Inheritance is how a child's surname derives from the parents' names.
+type Inheritance string
+
+const (
+	InheritSurname        Inheritance = "surname"         // the father's family name, inflected for gender where the profile does
+	InheritDouble         Inheritance = "double"          // father's first surname + mother's first surname (Spanish)
+	InheritDoubleMaternal Inheritance = "double-maternal" // mother's last surname + father's last surname (Portuguese)
+	InheritPatronymic     Inheritance = "patronymic"      // formed from the father's given name (Icelandic)
+	InheritMatronymic     Inheritance = "matronymic"      // formed from the mother's given name
+	InheritChain          Inheritance = "chain"           // father's and grandfather's given names (Amharic, Arabic)
+)
+
+// inheritance is each profile's default rule; profiles not listed use
+// InheritSurname. Profiles whose names are patronymic by default (Icelandic)
+// are detected from the founders' NameParts.
+var inheritance = map[string]Inheritance{
+	"spanish":    InheritDouble,
+	"portuguese": InheritDoubleMaternal,
+	"amharic":    InheritChain,
+	"arabic":     InheritChain,
+}
+
+// chainFamilyName lists chain profiles that also keep an inherited family
+// name after the chain ("Fadi Khalid Omar Abbas").
+var chainFamilyName = map[string]bool{"arabic": true}
+
+// marriedName lists profiles where a wife conventionally takes her husband's
+// surname (in its married form where the profile inflects it).
+var marriedName = map[string]bool{
+	"english": true, "celtic": true, "germanic": true, "french": true, "nordic": true, "slavic": true,
+	"baltic": true, "japanese": true, "greek": true, "turkish": true, "filipino": true, "hindi": true,
+}
+
+// familyFirst lists profiles whose names are written family name first.
+var familyFirst = map[string]bool{
+	"chinese": true, "japanese": true, "korean": true, "vietnamese": true,
+}
+
+var ErrOptions = errors.New("genealogy: bad options")
+
+// Options configures Generate. The zero value is usable.
+type Options struct {
+	Generations int         // generations including the founding couple; default 3
+	MinChildren int         // children per couple; default 1
+	MaxChildren int         // default 4
+	MarriagePct int         // chance a child marries and has children; default 75, negative for never
+	NamesakePct int         // chance a child is named after a grandparent; default 30, negative for never
+	MaxPeople   int         // stop adding people at this many; default 1000
+	StartYear   int         // founders' approximate birth year; default 1900
+	Inheritance Inheritance // override the profile's rule; "" for the default
+}
+
+func (o Options) withDefaults() (Options, error) {
+	if o.Generations == 0 {
+		o.Generations = 3
+	}
+	if o.MinChildren == 0 && o.MaxChildren == 0 {
+		o.MinChildren, o.MaxChildren = 1, 4
+	}
+	if o.MarriagePct == 0 {
+		o.MarriagePct = 75
+	}
+	if o.NamesakePct == 0 {
+		o.NamesakePct = 30
+	}
+	if o.MaxPeople == 0 {
+		o.MaxPeople = 1000
+	}
+	if o.StartYear == 0 {
+		o.StartYear = 1900
+	}
+	switch {
+	case o.Generations < 1:
+		return o, fmt.Errorf("%w: %d generations", ErrOptions, o.Generations)
+	case o.MinChildren < 0 || o.MaxChildren < o.MinChildren:
+		return o, fmt.Errorf("%w: children %d..%d", ErrOptions, o.MinChildren, o.MaxChildren)
+	case o.MaxPeople < 2:
+		return o, fmt.Errorf("%w: max people %d", ErrOptions, o.MaxPeople)
+	}
+	switch o.Inheritance {
+	case "", InheritSurname, InheritDouble, InheritDoubleMaternal, InheritPatronymic, InheritMatronymic, InheritChain:
+	default:
+		return o, fmt.Errorf("%w: unknown inheritance %q", ErrOptions, o.Inheritance)
+	}
+	return o, nil
+}
+
+// Person is one individual. IDs are GEDCOM-style ("I1", "F1") and stable
+// for a given seed.
+type Person struct {
+	ID         string `json:"id"`
+	Generation int    `json:"generation"` // 0 for the founders and their spouses' generation
+	Gender     string `json:"gender"`     // "male" or "female"
+	Given      string `json:"given"`
+	Middle     string `json:"middle,omitempty"`  // between family and given name (Vietnamese Thị, Văn)
+	Surname    string `json:"surname,omitempty"` // at birth
+	Name       string `json:"name"`              // display form, family name first where the culture does
+	BirthYear  int    `json:"birthYear"`
+
+	MarriedSurname string `json:"marriedSurname,omitempty"` // taken at marriage, where the culture does
+
+	// Forms from the profile, with cfg.Titles / cfg.Suffixes: Title goes
+	// before the name ("Dr."), Suffix after it ("Jr.", "san").
+	Title  string `json:"title,omitempty"`
+	Suffix string `json:"suffix,omitempty"`
+
+	Father     string `json:"father,omitempty"`     // person IDs; empty for people married into the tree
+	Mother     string `json:"mother,omitempty"`     //
+	Spouse     string `json:"spouse,omitempty"`     //
+	Family     string `json:"family,omitempty"`     // family ID of their own marriage
+	NamedAfter string `json:"namedAfter,omitempty"` // grandparent they were named after
+
+	// Parts is the structured name: surname root, sub-locale, and the parent
+	// names a patronymic was formed from.
+	Parts *api.NameParts `json:"parts,omitempty"`
+}
+
+// Family is a couple and their children.
+type Family struct {
+	ID       string   `json:"id"`
+	Husband  string   `json:"husband"`
+	Wife     string   `json:"wife"`
+	Children []string `json:"children,omitempty"`
+}
+
+// Tree is a generated genealogy. People and Families are in creation
+// order; the founding couple is I1 and I2 in F1.
+type Tree struct {
+	Profile     string      `json:"profile"`
+	Seed        int64       `json:"seed"`
+	Inheritance Inheritance `json:"inheritance"`
+	People      []Person    `json:"people"`
+	Families    []Family    `json:"families"`
+}
+
+// Person returns the person with the given ID.
+func (t Tree) Person(id string) (Person, bool) {
+	for _, p := range t.People {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return Person{}, false
+}
+
+// Family returns the family with the given ID.
+func (t Tree) Family(id string) (Family, bool) {
+	for _, f := range t.Families {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return Family{}, false
+}
+
+// node is a person plus the name state their descendants inherit from.
+type node struct {
+	Person
+	base        string   // surname root (dictionary form)
+	locale      string   // sub-locale of the surname
+	surnames    []string // double surnames, in order
+	father      string   // chain: father's and grandfather's given names
+	grandfather string   //
+	family      string   // chain: inherited family name, if the profile has one
+}
+
+type builder struct {
+	p      api.NameProfile
+	cfg    api.ProfileConfig
+	opts   Options
+	mode   string
+	rule   Inheritance
+	r      *rand.Rand
+	people []*node
+	byID   map[string]*node
+	fams   []*Family
+}
+
+// Generate builds a family tree of opts.Generations generations for
+// profile p. cfg supplies the profile settings (Mode, Family, Realism,
+// Filters, Titles, Suffixes); its Gender, IncludeLast and Constraints are
+// set per person.
+func Generate(p api.NameProfile, cfg api.ProfileConfig, opts Options) (Tree, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Tree{}, err
+	}
+	cfg.Seed = api.ResolveSeed(cfg.Seed)
+	cfg.Count = 1
+	cfg.Constraints = api.Constraints{}
+	cfg.Nicknames = false
+
+	b := &builder{
+		p:    p,
+		cfg:  cfg,
+		opts: opts,
+		mode: strings.ToLower(cfg.Mode),
+		r:    rand.New(rand.NewSource(api.DeriveSeed(cfg.Seed, "genealogy"))),
+		byID: map[string]*node{},
+	}
+	b.rule = b.resolveRule()
+	if (b.rule == InheritPatronymic || b.rule == InheritMatronymic) && !isPatronymicFormer(p) {
+		return Tree{}, fmt.Errorf("%w: profile %q cannot form %ss", ErrOptions, cfg.Mode, b.rule)
+	}
+
+	husband, err := b.founder("male", 0, opts.StartYear)
+	if err != nil {
+		return Tree{}, err
+	}
+	if opts.Inheritance == "" && cfg.Convention == "" && husband.Parts != nil {
+		// The profile's own default, e.g. Icelandic patronymics.
+		switch c := Inheritance(husband.Parts.Convention); c {
+		case InheritPatronymic, InheritMatronymic:
+			b.rule = c
+		}
+	}
+	wife, err := b.founder("female", 0, opts.StartYear+b.r.Intn(7)-3)
+	if err != nil {
+		return Tree{}, err
+	}
+	couples := []*Family{b.marry(husband, wife)}
+
+	for gen := 1; gen < opts.Generations && len(couples) > 0; gen++ {
+		var next []*Family
+		for _, f := range couples {
+			n := opts.MinChildren + b.r.Intn(opts.MaxChildren-opts.MinChildren+1)
+			year := b.byID[f.Wife].BirthYear + 20 + b.r.Intn(10)
+			for k := 0; k < n && len(b.people) < opts.MaxPeople; k++ {
+				gender := "male"
+				if b.r.Intn(2) == 0 {
+					gender = "female"
+				}
+				child, err := b.child(f, gender, gen, year)
+				if err != nil {
+					return Tree{}, err
+				}
+				year += 1 + b.r.Intn(4)
+
+				if gen == opts.Generations-1 || len(b.people) >= opts.MaxPeople || !api.Chance(b.r, opts.MarriagePct) {
+					continue
+				}
+				spouse, err := b.founder(opposite(gender), gen, child.BirthYear+b.r.Intn(9)-4)
+				if err != nil {
+					return Tree{}, err
+				}
+				if gender == "male" {
+					next = append(next, b.marry(child, spouse))
+				} else {
+					next = append(next, b.marry(spouse, child))
+				}
+			}
+		}
+		couples = next
+	}
+
+	t := Tree{Profile: b.mode, Seed: cfg.Seed, Inheritance: b.rule}
+	for _, n := range b.people {
+		t.People = append(t.People, n.Person)
+	}
+	for _, f := range b.fams {
+		t.Families = append(t.Families, *f)
+	}
+	return t, nil
+}
+
+func (b *builder) resolveRule() Inheritance {
+	if b.opts.Inheritance != "" {
+		return b.opts.Inheritance
+	}
+	switch c := Inheritance(strings.ToLower(b.cfg.Convention)); c {
+	case InheritPatronymic, InheritMatronymic:
+		return c
+	}
+	if rule, ok := inheritance[b.mode]; ok {
+		return rule
+	}
+	return InheritSurname
+}
+
+// generate asks the profile for the name of the next person.
+func (b *builder) generate(gender string, withLast bool, label string) (api.NameResult, error) {
+	cfg := b.cfg
+	cfg.Gender = gender
+	cfg.IncludeLast = withLast
+	cfg.Seed = api.DeriveSeed(b.cfg.Seed, "genealogy", fmt.Sprintf("I%d", len(b.people)+1), label)
+	switch b.rule {
+	case InheritPatronymic, InheritMatronymic:
+		cfg.Convention = string(b.rule)
+	case InheritChain:
+		cfg.Convention, cfg.Depth = api.ConventionChain, 2
+	}
+	return api.Generate(b.p, cfg)
+}
+
+// add assigns n the next ID and its display name.
+func (b *builder) add(n *node) *node {
+	n.ID = fmt.Sprintf("I%d", len(b.people)+1)
+	n.Name = b.display(n.Given, n.Middle, n.Surname)
+	b.people = append(b.people, n)
+	b.byID[n.ID] = n
+	return n
+}
+
+func (b *builder) display(given, middle, surname string) string {
+	if surname != "" && (familyFirst[b.mode] || b.cfg.Reverse) {
+		return joinNonEmpty(surname, middle, given)
+	}
+	return joinNonEmpty(given, middle, surname)
+}
+
+// founder creates someone without parents in the tree: the founding couple
+// and everyone who marries in. Their names come straight from the profile.
+func (b *builder) founder(gender string, gen, year int) (*node, error) {
+	res, err := b.generate(gender, true, "")
+	if err != nil {
+		return nil, err
+	}
+	n := &node{Person: Person{Generation: gen, Gender: gender, Given: res.First, Middle: api.MiddleName(res), Surname: res.Last, BirthYear: year, Parts: res.Parts}}
+	n.Title, n.Suffix = splitForms(res)
+	n.base = res.Last
+	if res.Parts != nil {
+		n.locale = res.Parts.Locale
+		if res.Parts.SurnameBase != "" {
+			n.base = res.Parts.SurnameBase
+		}
+	}
+
+	switch b.rule {
+	case InheritDouble, InheritDoubleMaternal:
+		if res.Parts != nil && len(res.Parts.Surnames) > 0 {
+			// Iberian profiles give both surnames in display order.
+			n.surnames = res.Parts.Surnames
+			break
+		}
+		second, err := b.generate(gender, true, "second")
+		if err != nil {
+			return nil, err
+		}
+		n.surnames = []string{res.Last}
+		if second.Last != "" && second.Last != res.Last {
+			n.surnames = append(n.surnames, second.Last)
+		}
+		n.Surname = strings.Join(n.surnames, " ")
+
+	case InheritChain:
+		// Name the founder's father and grandfather too, so the chain is
+		// complete from the first generation.
+		if res.Parts != nil && len(res.Parts.Ancestors) >= 2 {
+			// The profile named them itself.
+			n.father, n.grandfather = res.Parts.Ancestors[0], res.Parts.Ancestors[1]
+			if chainFamilyName[b.mode] {
+				n.family = res.Parts.SurnameBase
+			}
+		} else {
+			if chainFamilyName[b.mode] {
+				n.family = res.Last
+				father, err := b.generate("male", false, "father")
+				if err != nil {
+					return nil, err
+				}
+				n.father = father.First
+			} else {
+				n.father = res.Last
+			}
+			grandfather, err := b.generate("male", false, "grandfather")
+			if err != nil {
+				return nil, err
+			}
+			n.grandfather = grandfather.First
+		}
+		n.Surname = joinNonEmpty(n.father, n.grandfather, n.family)
+		n.Parts = &api.NameParts{Convention: string(InheritChain), SurnameBase: n.family, Father: n.father}
+	}
+	return b.add(n), nil
+}
+
+// child creates a child of family f, named by the tree's inheritance rule.
+func (b *builder) child(f *Family, gender string, gen, year int) (*node, error) {
+	father, mother := b.byID[f.Husband], b.byID[f.Wife]
+	n := &node{Person: Person{Generation: gen, Gender: gender, BirthYear: year, Father: father.ID, Mother: mother.ID}}
+
+	res, err := b.generate(gender, false, "")
+	if err != nil {
+		return nil, err
+	}
+	n.Given, n.Middle = res.First, api.MiddleName(res)
+	n.Title, n.Suffix = splitForms(res)
+	if namesake := b.namesake(f, father, mother, gender); namesake != nil {
+		n.Given = namesake.Given
+		n.NamedAfter = namesake.ID
+	}
+
+	switch b.rule {
+	case InheritDouble:
+		n.surnames = []string{first(father.surnames), first(mother.surnames)}
+		n.Surname = joinNonEmpty(n.surnames...)
+		n.Parts = &api.NameParts{Convention: api.ConventionDouble, Surnames: n.surnames, Paternal: n.surnames[0], Maternal: n.surnames[1]}
+
+	case InheritDoubleMaternal:
+		n.surnames = []string{last(mother.surnames), last(father.surnames)}
+		n.Surname = joinNonEmpty(n.surnames...)
+		n.Parts = &api.NameParts{Convention: api.ConventionDouble, Surnames: n.surnames, Paternal: n.surnames[1], Maternal: n.surnames[0]}
+
+	case InheritPatronymic, InheritMatronymic:
+		pf := b.p.(api.PatronymicFormer)
+		n.locale = father.locale
+		n.Parts = &api.NameParts{Locale: n.locale, Convention: string(b.rule), Father: father.Given, Mother: mother.Given}
+		if b.rule == InheritMatronymic {
+			n.Surname = pf.FormPatronymic(mother.Given, true, gender, n.locale)
+		} else {
+			n.Surname = pf.FormPatronymic(father.Given, false, gender, n.locale)
+		}
+
+	case InheritChain:
+		n.father, n.grandfather, n.family = father.Given, father.father, father.family
+		n.Surname = joinNonEmpty(n.father, n.grandfather, n.family)
+		n.Parts = &api.NameParts{Convention: string(InheritChain), SurnameBase: n.family, Father: n.father}
+
+	default:
+		n.base, n.locale = father.base, father.locale
+		form := api.SurnameMasculine
+		if gender == "female" {
+			form = api.SurnameMaiden
+		}
+		n.Surname = api.InflectSurname(b.p, n.base, form, n.locale)
+		if _, ok := b.p.(api.SurnameInflector); ok {
+			n.Parts = &api.NameParts{Locale: n.locale, Convention: string(InheritSurname), SurnameBase: n.base, SurnameForm: form}
+		}
+	}
+
+	b.add(n)
+	f.Children = append(f.Children, n.ID)
+	return n, nil
+}
+
+// namesake picks a grandparent to name a child after: sons after the
+// paternal then the maternal grandfather, daughters after the grandmothers,
+// each name used once per family.
+func (b *builder) namesake(f *Family, father, mother *node, gender string) *node {
+	if b.opts.NamesakePct < 0 || !api.Chance(b.r, b.opts.NamesakePct) {
+		return nil
+	}
+	parent := func(n *node) string { return n.Father }
+	if gender == "female" {
+		parent = func(n *node) string { return n.Mother }
+	}
+	for _, p := range []*node{father, mother} {
+		gp, ok := b.byID[parent(p)]
+		if !ok || b.usedIn(f, gp.Given) {
+			continue
+		}
+		return gp
+	}
+	return nil
+}
+
+func (b *builder) usedIn(f *Family, given string) bool {
+	for _, id := range f.Children {
+		if b.byID[id].Given == given {
+			return true
+		}
+	}
+	return false
+}
+
+// marry records a couple as a new family. Where the culture has wives take
+// their husband's surname, the wife gets its married form.
+func (b *builder) marry(husband, wife *node) *Family {
+	f := &Family{ID: fmt.Sprintf("F%d", len(b.fams)+1), Husband: husband.ID, Wife: wife.ID}
+	b.fams = append(b.fams, f)
+	husband.Spouse, wife.Spouse = wife.ID, husband.ID
+	husband.Family, wife.Family = f.ID, f.ID
+
+	if b.rule == InheritSurname && marriedName[b.mode] && husband.base != "" {
+		married := api.InflectSurname(b.p, husband.base, api.SurnameMarried, husband.locale)
+		if married != wife.Surname {
+			wife.MarriedSurname = married
+		}
+	}
+	return f
+}
+
+// splitForms places the profile's title before or after the name, the way
+// the formal name uses it, and joins after-titles with the suffix.
+func splitForms(res api.NameResult) (prefix, suffix string) {
+	suffix = res.Suffix
+	if res.Title == "" {
+		return "", suffix
+	}
+	if strings.HasPrefix(res.Formal, res.Title) {
+		return res.Title, suffix
+	}
+	return "", joinNonEmpty(res.Title, suffix)
+}
+
+func isPatronymicFormer(p api.NameProfile) bool {
+	_, ok := p.(api.PatronymicFormer)
+	return ok
+}
+
+func opposite(gender string) string {
+	if gender == "male" {
+		return "female"
+	}
+	return "male"
+}
+
+func first(s []string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[0]
+}
+
+func last(s []string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[len(s)-1]
+}
+
+func joinNonEmpty(parts ...string) string {
+	var out []string
+	for _, s := range parts {
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return strings.Join(out, " ")
+}
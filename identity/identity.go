@@ -0,0 +1,302 @@
+// Package identity derives fake-person records from generated names:
+// usernames, handles, e-mail addresses on reserved example domains,
+// initials, a sortable name, an honorific and a birthdate.
+//
+// Everything is derived from NameResult.Seed (or, for a grafted name with
+// seed 0, from the name) and ages are computed at a fixed date, so the same
+// name and seed always give the same identity.
+//
+// Usage:
+//
+//	res, _ := api.Generate(profile, cfg)
+//	id, err := identity.New(res, cfg, identity.Options{})
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/nsa-yoda/namegen/api"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultDomains are the RFC 2606 second-level example domains.
+var DefaultDomains = []string{"example.com", "example.net", "example.org"}
+
+var ErrDomain = errors.New("identity: e-mail domain is not reserved for examples")
+
+// familyFirst lists profiles whose names are conventionally written family
+// name first; their usernames lean toward family-first patterns.
+var familyFirst = map[string]bool{
+	"chinese": true, "japanese": true, "korean": true, "vietnamese": true,
+}
+
+// AgeDistribution is a normal distribution truncated to [Min, Max] years.
+type AgeDistribution struct {
+	Min    int     `json:"min"`
+	Max    int     `json:"max"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stdDev"`
+}
+
+// DefaultAge covers adults, centred on 40.
+var DefaultAge = AgeDistribution{Min: 18, Max: 80, Mean: 40, StdDev: 15}
+
+// DefaultAsOf is the date ages are computed at when Options.AsOf is zero.
+// It is fixed, not today, so a seed gives the same birthdate every day.
+var DefaultAsOf = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Options configures New. The zero value is usable.
+type Options struct {
+	Domains []string        // e-mail domains; default DefaultDomains
+	Age     AgeDistribution // default DefaultAge
+	AsOf    time.Time       // date ages are computed at; default DefaultAsOf
+}
+
+// Identity is a fake person built around one generated name.
+type Identity struct {
+	FullName  string `json:"fullName"`
+	SortName  string `json:"sortName"`
+	Initials  string `json:"initials"`
+	Honorific string `json:"honorific"`
+	Username  string `json:"username"`
+	Handle    string `json:"handle"`
+	Email     string `json:"email"`
+	Birthdate string `json:"birthdate"` // YYYY-MM-DD
+	Age       int    `json:"age"`
+}
+
+// New derives an Identity for res. cfg supplies the profile (cfg.Mode),
+// gender and name order (cfg.Reverse) used to generate res.
+func New(res api.NameResult, cfg api.ProfileConfig, opts Options) (Identity, error) {
+	domains := opts.Domains
+	if len(domains) == 0 {
+		domains = DefaultDomains
+	}
+	for _, d := range domains {
+		if strings.TrimSpace(d) == "" {
+			return Identity{}, fmt.Errorf("%w: empty domain", ErrDomain)
+		}
+		if !IsReservedDomain(d) {
+			return Identity{}, fmt.Errorf("%w: %q", ErrDomain, d)
+		}
+	}
+	age := opts.Age
+	if age == (AgeDistribution{}) {
+		age = DefaultAge
+	}
+	if age.Min < 0 || age.Max < age.Min {
+		return Identity{}, fmt.Errorf("identity: bad age range %d..%d", age.Min, age.Max)
+	}
+	asOf := opts.AsOf
+	if asOf.IsZero() {
+		asOf = DefaultAsOf
+	}
+
+	seed := res.Seed
+	if seed == 0 {
+		// A grafted name has no seed of its own; derive from the name.
+		seed = api.SeedFromString(res.First + " " + res.Last)
+	}
+	r := rand.New(rand.NewSource(api.DeriveSeed(seed, "identity")))
+	given, family := slug(res.First), slug(res.Last)
+	famFirst := familyFirst[strings.ToLower(cfg.Mode)]
+
+	id := Identity{
+		FullName:  fullName(res, famFirst || cfg.Reverse),
+		SortName:  sortName(res, famFirst || cfg.Reverse),
+		Initials:  initials(res),
+		Honorific: honorific(cfg.Gender, r),
+	}
+	if res.Title != "" {
+		id.Honorific = res.Title // the profile's own form (-titles)
+	}
+
+	id.Username = username(r, given, family, famFirst)
+	id.Handle = "@" + handle(r, given, family)
+	id.Email = emailLocal(r, given, family, famFirst) + "@" + api.PickRand(domains, r)
+
+	birth := birthdate(r, age, asOf)
+	id.Birthdate = birth.Format("2006-01-02")
+	id.Age = yearsBetween(birth, asOf)
+	return id, nil
+}
+
+// IsReservedDomain reports whether d is an RFC 2606 / RFC 6761 domain that
+// can never receive real mail: example.com/.net/.org or anything under the
+// .example, .test, .invalid and .localhost TLDs.
+func IsReservedDomain(d string) bool {
+	d = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(d)), ".")
+	switch d {
+	case "example.com", "example.net", "example.org":
+		return true
+	}
+	for _, suffix := range []string{"example.com", "example.net", "example.org"} {
+		if strings.HasSuffix(d, "."+suffix) {
+			return true
+		}
+	}
+	for _, tld := range []string{".example", ".test", ".invalid", ".localhost"} {
+		if strings.HasSuffix(d, tld) {
+			return true
+		}
+	}
+	return false
+}
+
+func fullName(res api.NameResult, famFirst bool) string {
+	return api.OrderedName(res, famFirst)
+}
+
+// sortName is "Family, Given" with the given names in the order they are
+// written: "Dương, Thị Hạnh", "Smith, John".
+func sortName(res api.NameResult, famFirst bool) string {
+	given := api.OrderedName(api.NameResult{First: res.First, Parts: res.Parts}, famFirst)
+	if res.Last == "" {
+		return given
+	}
+	return res.Last + ", " + given
+}
+
+func initials(res api.NameResult) string {
+	var b strings.Builder
+	for _, part := range strings.Fields(api.OrderedName(res, false)) {
+		for _, ch := range part {
+			if unicode.IsLetter(ch) {
+				b.WriteRune(unicode.ToUpper(ch))
+				b.WriteByte('.')
+				break
+			}
+		}
+	}
+	return b.String()
+}
+
+func honorific(gender string, r api.RandLike) string {
+	switch gender {
+	case "male":
+		return "Mr."
+	case "female":
+		return api.PickRand([]string{"Ms.", "Ms.", "Ms.", "Mrs.", "Miss"}, r)
+	}
+	return "Mx."
+}
+
+// username builds e.g. "yui.tanaka", "tanaka.yui", "ytanaka42", "yui_t".
+func username(r api.RandLike, given, family string, famFirst bool) string {
+	if family == "" {
+		return api.PickRand([]string{
+			given,
+			given + twoDigits(r),
+			given + "_" + strconv.Itoa(1970+r.Intn(40)),
+		}, r)
+	}
+	first, second := given, family
+	if famFirst && r.Intn(100) < 50 {
+		first, second = family, given
+	}
+	return api.PickRand([]string{
+		first + "." + second,
+		first + "." + second,
+		first + "_" + second,
+		firstLetter(given) + family + twoDigits(r),
+		given + firstLetter(family),
+		given + "." + firstLetter(family) + twoDigits(r),
+		first + second,
+	}, r)
+}
+
+// handle builds a social-media style handle without separators.
+func handle(r api.RandLike, given, family string) string {
+	if family == "" {
+		return given + twoDigits(r)
+	}
+	return api.PickRand([]string{
+		firstLetter(given) + family + twoDigits(r),
+		given + family,
+		given + "_" + family,
+		given + strconv.Itoa(r.Intn(1000)),
+		"the" + given + family,
+	}, r)
+}
+
+// emailLocal builds the part before the @: mostly conventional company
+// formats, sometimes a personal-mailbox style with digits.
+func emailLocal(r api.RandLike, given, family string, famFirst bool) string {
+	if family == "" {
+		return given + twoDigits(r)
+	}
+	opts := []string{
+		given + "." + family,
+		given + "." + family,
+		firstLetter(given) + family,
+		firstLetter(given) + "." + family,
+		given + family + twoDigits(r),
+		given + "_" + family,
+	}
+	if famFirst {
+		opts = append(opts, family+"."+given)
+	}
+	return api.PickRand(opts, r)
+}
+
+// birthdate samples an age from dist and a uniformly random day within
+// that year of life, relative to asOf.
+func birthdate(r *rand.Rand, dist AgeDistribution, asOf time.Time) time.Time {
+	years := dist.Mean
+	if dist.StdDev > 0 {
+		years += r.NormFloat64() * dist.StdDev
+	}
+	years = math.Max(float64(dist.Min), math.Min(float64(dist.Max), years))
+	age := int(years)
+
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	latest := asOf.AddDate(-age, 0, 0)               // turned `age` today
+	earliest := asOf.AddDate(-age-1, 0, 1)           // turns `age+1` tomorrow
+	days := int(latest.Sub(earliest).Hours()/24) + 1 // inclusive
+	return earliest.AddDate(0, 0, r.Intn(days))
+}
+
+func yearsBetween(birth, asOf time.Time) int {
+	years := asOf.Year() - birth.Year()
+	if asOf.Month() < birth.Month() || (asOf.Month() == birth.Month() && asOf.Day() < birth.Day()) {
+		years--
+	}
+	return years
+}
+
+func twoDigits(r api.RandLike) string {
+	return fmt.Sprintf("%02d", r.Intn(100))
+}
+
+func firstLetter(s string) string {
+	if s == "" {
+		return ""
+	}
+	return s[:1]
+}
+
+// asciiLetters maps letters that do not decompose into ASCII + marks.
+var asciiLetters = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "ae", "ø", "o", "Ø", "o", "œ", "oe", "Œ", "oe",
+	"đ", "d", "Đ", "d", "ł", "l", "Ł", "l", "ı", "i", "þ", "th", "ð", "d",
+	"ʻ", "", "'", "", "’", "",
+)
+
+// slug folds a name part to lowercase ASCII letters and digits.
+func slug(s string) string {
+	s = norm.NFD.String(asciiLetters.Replace(s))
+	var b strings.Builder
+	for _, ch := range strings.ToLower(s) {
+		if ch < unicode.MaxASCII && (unicode.IsLetter(ch) || unicode.IsDigit(ch)) {
+			b.WriteRune(ch)
+		}
+	}
+	return b.String()
+}
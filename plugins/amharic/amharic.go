@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type amharicProfile struct{}
@@ -19,12 +17,53 @@ func init() {
 func (p amharicProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Amharic/Ethiopian names (ASCII): patronymic-style, curated + procedural; deterministic",
+		"notes": "Ethiopian and Eritrean names: given name + father (+ grandfather, -depth 2), -family tigrinya, Ge'ez script; curated + procedural; deterministic",
 	}
 }
 
-// Ethiopian names usually don't have surnames in the Western sense;
-// we still generate a second name when includeLast is true.
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p amharicProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           givenMale, // the father's given name
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: givenEndings,
+	}
+}
+
+// Scripts lists the native script: Ge'ez (Fidel).
+func (p amharicProfile) Scripts() []string {
+	return []string{"geez"}
+}
+
+// Forms gives Ato and Weyzero, used with the given name, not the father's.
+func (p amharicProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Ato", Gender: "male", Weight: 50, Use: api.UseGiven},
+			{Text: "Weyzero", Gender: "female", Weight: 40, Use: api.UseGiven},
+			{Text: "Weyzerit", Gender: "female", Weight: 10, Use: api.UseGiven},
+			{Text: "Dr.", Weight: 5, Use: api.UseGiven},
+			{Text: "Lij", Gender: "male", Weight: 1, Use: api.UseGiven},
+		},
+	}
+}
+
+// NicknameRules gives the curated short forms (Yohannes -> Jo); other names
+// get no nicknames beyond their first syllable.
+func (p amharicProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated: nicknames,
+	}
+}
+
+// Ethiopian and Eritrean names have no surname: the second name is the
+// father's given name and the third, where used, the grandfather's.
 var givenMale = []string{
 	"Abebe", "Bekele", "Dawit", "Tesfaye", "Kebede", "Getachew", "Yohannes", "Mulugeta", "Solomon", "Alemayehu",
 	"Biruk", "Girma", "Haile", "Mengistu", "Tadesse", "Fikru", "Eshetu", "Seifu", "Addisu", "Zerihun",
@@ -39,9 +78,63 @@ var givenNeutral = []string{
 	"Selam", "Biruk", "Mulu", "Genet", "Eden", "Liya", "Saba", "Haile", "Solomon", "Addisu",
 }
 
-// Used as second names / patronymics
-var surnames = []string{
-	"Bekele", "Tesfaye", "Kebede", "Abebe", "Getachew", "Alemayehu", "Girma", "Haile", "Mengistu", "Tadesse",
+// Tigrinya names (Eritrea, Tigray), selected with -family tigrinya.
+var tigrinyaMale = []string{
+	"Tesfay", "Haile", "Gebremedhin", "Tekle", "Berhane", "Yonas", "Mehari", "Kidane", "Ghebreyesus", "Isaias",
+	"Semere", "Filmon", "Dawit", "Amanuel", "Habtom", "Tsegay", "Kiflom", "Yemane", "Mebrahtu", "Afewerki",
+}
+
+var tigrinyaFemale = []string{
+	"Senait", "Luwam", "Yordanos", "Selam", "Abeba", "Mihret", "Ruta", "Tirhas", "Freweini", "Saba",
+	"Letensae", "Winta", "Elsa", "Hiwet", "Mebrat", "Tsega", "Azieb", "Nebiat", "Semhar", "Helen",
+}
+
+var tigrinyaNeutral = []string{
+	"Selam", "Tsega", "Saba", "Mihret", "Hiwet", "Dawit", "Haile", "Kidane", "Yonas", "Semhar",
+}
+
+// Sub-locales (cfg.Family).
+const (
+	localeAmharic  = "amharic"
+	localeTigrinya = "tigrinya"
+)
+
+var localeAliases = map[string]string{
+	"amharic": localeAmharic, "ethiopian": localeAmharic, "ethiopia": localeAmharic, "am": localeAmharic,
+	"tigrinya": localeTigrinya, "tigrigna": localeTigrinya, "eritrean": localeTigrinya, "eritrea": localeTigrinya,
+	"tigray": localeTigrinya, "ti": localeTigrinya,
+}
+
+// resolveLocale turns a cfg.Family value into a sub-locale, Amharic by default.
+func resolveLocale(family string) string {
+	if loc, ok := localeAliases[strings.ToLower(strings.TrimSpace(family))]; ok {
+		return loc
+	}
+	return localeAmharic
+}
+
+// Given-name pools per locale: male, female, neutral.
+var pools = map[string][3][]string{
+	localeAmharic:  {givenMale, givenFemale, givenNeutral},
+	localeTigrinya: {tigrinyaMale, tigrinyaFemale, tigrinyaNeutral},
+}
+
+// defaultDepth is how many ancestors follow the given name without
+// cfg.Depth: the father. -depth 2 adds the grandfather.
+const (
+	defaultDepth = 1
+	maxDepth     = 4
+)
+
+// resolveDepth clamps cfg.Depth, defaulting to the father alone.
+func resolveDepth(depth int) int {
+	switch {
+	case depth <= 0:
+		return defaultDepth
+	case depth > maxDepth:
+		return maxDepth
+	}
+	return depth
 }
 
 // Amharic romanized phonotactics
@@ -62,11 +155,49 @@ var codas = []string{
 }
 
 var givenEndings = []string{"", "", "", "e", "u", "a", "ye"}
-var surnameEndings = []string{"", "", "", "ye", "w", "e"}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"yohannes":   {"Jo", "Yoni", "Yohi"},
+	"dawit":      {"Dave", "Dati"},
+	"solomon":    {"Sol", "Solo"},
+	"alemayehu":  {"Alex", "Alemu"},
+	"mulugeta":   {"Mulu"},
+	"tesfaye":    {"Tesfa"},
+	"getachew":   {"Geta"},
+	"biruktawit": {"Bruki", "Biruk"},
+	"frehiwot":   {"Fre"},
+	"mekdes":     {"Meki"},
+	"tigist":     {"Tigi"},
+	"meseret":    {"Mesi"},
+	"yodit":      {"Yodi"},
+	"rahel":      {"Rahi"},
+	"haile":      {"Hailu"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) +
+		api.PickRand(vowels, r) +
+		api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2)
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 45 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
 
 func (p amharicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -93,65 +224,56 @@ func (p amharicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) +
-			api.PickRand(vowels, r) +
-			api.PickRand(codas, r)
-	}
 
-	genGivenProcedural := func() string {
-		n := 2 + r.Intn(2)
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 45 {
-			b.WriteString(api.PickRand(givenEndings, r))
+	locale := resolveLocale(cfg.Family)
+	first := pickGiven(r, cfg.Gender, locale, realism, useRealPct)
+	res := api.NameResult{First: first}
+	native := api.NativeScript(p, cfg) != ""
+	if !cfg.IncludeLast {
+		if native {
+			res.Native = fidel(first, locale)
 		}
-		return b.String()
+		return res, nil
 	}
 
-	genSurnameProcedural := func() string {
-		n := 2
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
+	// The father's name (and the grandfather's) is a man's given name
+	// whatever the gender of the person named, and differs from the
+	// child's: "Kiflom Kiflom" is re-drawn.
+	var ancestors []string
+	child := first
+	for range resolveDepth(cfg.Depth) {
+		name := pickGiven(r, "male", locale, realism, useRealPct)
+		for name == child {
+			name = pickGiven(r, "male", locale, realism, useRealPct)
 		}
-		if r.Intn(100) < 50 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
-
-	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(givenMale, r)
-		case "female":
-			first = api.PickRand(givenFemale, r)
-		default:
-			first = api.PickRand(givenNeutral, r)
-		}
-	} else {
-		first = caser.String(genGivenProcedural())
+		ancestors = append(ancestors, name)
+		child = name
 	}
-
-	last := ""
-	if cfg.IncludeLast {
-		if chooseFromReal() {
-			last = api.PickRand(surnames, r)
-		} else {
-			last = caser.String(genSurnameProcedural())
+	res.Last = strings.Join(ancestors, " ")
+	res.Parts = &api.NameParts{Locale: locale, Convention: api.ConventionChain, Ancestors: ancestors, Father: ancestors[0]}
+	if native {
+		written := []string{fidel(first, locale)}
+		for _, a := range ancestors {
+			written = append(written, fidel(a, locale))
 		}
+		res.Native = strings.Join(written, " ")
 	}
+	return res, nil
+}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+// pickGiven picks a given name from the locale's curated pool or makes one up.
+func pickGiven(r api.RandLike, gender, locale string, realism, useRealPct int) string {
+	if !api.Chance(r, useRealPct) {
+		return api.Title(genGivenProcedural(r, realism))
+	}
+	pool := pools[locale]
+	switch gender {
+	case "male":
+		return api.PickRand(pool[0], r)
+	case "female":
+		return api.PickRand(pool[1], r)
+	}
+	return api.PickRand(pool[2], r)
 }
 
 var Profile amharicProfile
@@ -0,0 +1,109 @@
+package amharic
+
+import (
+	"strings"
+)
+
+// Fidel (Ge'ez script) is an abugida: each consonant has seven vowel
+// orders, laid out in Unicode as base+0 .. base+6.
+var fidelBases = map[string]rune{
+	"h": 'ሀ', "l": 'ለ', "m": 'መ', "r": 'ረ', "s": 'ሰ', "sh": 'ሸ', "q": 'ቀ',
+	"b": 'በ', "v": 'ቨ', "t": 'ተ', "ch": 'ቸ', "n": 'ነ', "k": 'ከ', "w": 'ወ',
+	"z": 'ዘ', "y": 'የ', "d": 'ደ', "j": 'ጀ', "g": 'ገ', "ts": 'ጸ', "f": 'ፈ',
+	"p": 'ፐ',
+}
+
+// glottal is the vowel carrier (አ) for vowels without a consonant.
+const glottal = 'አ'
+
+// Vowel orders of the common romanization: e is the first order (ä),
+// a consonant without a vowel is the sixth.
+var vowelOrders = map[string]int{
+	"e": 0, "u": 1, "i": 2, "a": 3, "aa": 3, "ee": 4, "ie": 4, "o": 6,
+}
+
+// Word-initial vowels take these orders of አ: Abebe አበበ, Eshetu እሸቱ.
+// Tigrinya writes an initial a as ኣ (Abeba ኣበባ).
+var initialOrders = map[string]int{
+	"a": 0, "aa": 3, "e": 5, "u": 1, "i": 2, "ee": 4, "ie": 4, "o": 6,
+}
+
+// toFidel writes a romanized name in Fidel, syllable by syllable. It is
+// used for procedural names; curated names carry their spelling.
+func toFidel(name, locale string) string {
+	s := strings.ToLower(name)
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		consonant := ""
+		for _, c := range []string{s[i:min(i+2, len(s))], s[i : i+1]} {
+			if _, ok := fidelBases[c]; ok {
+				consonant = c
+				break
+			}
+		}
+		i += len(consonant)
+		vowel := ""
+		for _, v := range []string{s[i:min(i+2, len(s))], s[i:min(i+1, len(s))]} {
+			if _, ok := vowelOrders[v]; ok && v != "" {
+				vowel = v
+				break
+			}
+		}
+		i += len(vowel)
+		switch {
+		case consonant != "" && vowel != "":
+			b.WriteRune(fidelBases[consonant] + rune(vowelOrders[vowel]))
+		case consonant != "":
+			b.WriteRune(fidelBases[consonant] + 5)
+		case vowel != "":
+			order := vowelOrders[vowel]
+			if b.Len() == 0 {
+				order = initialOrders[vowel]
+				if locale == localeTigrinya && vowel == "a" {
+					order = 3
+				}
+			}
+			b.WriteRune(glottal + rune(order))
+		default:
+			i++ // not a letter Fidel spells
+		}
+	}
+	return b.String()
+}
+
+// Curated spellings. Tigrinya writes some names differently (Haile: ኃይሌ
+// in Amharic, ሃይለ in Tigrinya), so its table is consulted first.
+var fidelAmharic = map[string]string{
+	"Abebe": "አበበ", "Bekele": "በቀለ", "Dawit": "ዳዊት", "Tesfaye": "ተስፋዬ", "Kebede": "ከበደ",
+	"Getachew": "ጌታቸው", "Yohannes": "ዮሐንስ", "Mulugeta": "ሙሉጌታ", "Solomon": "ሰሎሞን", "Alemayehu": "ዓለማየሁ",
+	"Biruk": "ብሩክ", "Girma": "ግርማ", "Haile": "ኃይሌ", "Mengistu": "መንግሥቱ", "Tadesse": "ታደሰ",
+	"Fikru": "ፍቅሩ", "Eshetu": "እሸቱ", "Seifu": "ሰይፉ", "Addisu": "አዲሱ", "Zerihun": "ዘሪሁን",
+	"Almaz": "አልማዝ", "Hanna": "ሐና", "Selam": "ሰላም", "Mulu": "ሙሉ", "Meseret": "መሠረት",
+	"Tigist": "ትዕግሥት", "Rahel": "ራሔል", "Saba": "ሳባ", "Aster": "አስቴር", "Genet": "ገነት",
+	"Wubit": "ውቢት", "Eden": "ኤደን", "Liya": "ሊያ", "Frehiwot": "ፍሬሕይወት", "Biruktawit": "ብሩክታዊት",
+	"Yeshi": "የሺ", "Marta": "ማርታ", "Tsedey": "ፀደይ", "Yodit": "ዮዲት", "Mekdes": "መቅደስ",
+}
+
+var fidelTigrinya = map[string]string{
+	"Tesfay": "ተስፋይ", "Haile": "ሃይለ", "Gebremedhin": "ገብረመድህን", "Tekle": "ተኽለ", "Berhane": "በርሃነ",
+	"Yonas": "ዮናስ", "Mehari": "መሓሪ", "Kidane": "ኪዳነ", "Ghebreyesus": "ገብረየሱስ", "Isaias": "ኢሳይያስ",
+	"Semere": "ሰመረ", "Filmon": "ፍልሞን", "Amanuel": "ኣማኑኤል", "Habtom": "ሃብቶም", "Tsegay": "ጸጋይ",
+	"Kiflom": "ክፍሎም", "Yemane": "የማነ", "Mebrahtu": "መብራህቱ", "Afewerki": "ኣፈወርቂ",
+	"Senait": "ሰናይት", "Luwam": "ሉዋም", "Yordanos": "ዮርዳኖስ", "Abeba": "ኣበባ", "Mihret": "ምሕረት",
+	"Ruta": "ሩታ", "Tirhas": "ትርሓስ", "Freweini": "ፍረወይኒ", "Letensae": "ለተንሳኤ", "Winta": "ዊንታ",
+	"Elsa": "ኤልሳ", "Hiwet": "ሕይወት", "Mebrat": "መብራት", "Tsega": "ጸጋ", "Azieb": "ኣዚብ",
+	"Nebiat": "ነብያት", "Semhar": "ሰምሃር", "Helen": "ሄለን",
+}
+
+// fidel spells a given name in Fidel for the locale.
+func fidel(name, locale string) string {
+	if locale == localeTigrinya {
+		if f, ok := fidelTigrinya[name]; ok {
+			return f
+		}
+	}
+	if f, ok := fidelAmharic[name]; ok {
+		return f
+	}
+	return toFidel(name, locale)
+}
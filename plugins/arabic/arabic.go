@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type arabicProfile struct{}
@@ -19,7 +17,49 @@ func init() {
 func (p arabicProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Arabic names: realism blends curated transliterated lists with procedural syllables; deterministic with seed",
+		"notes": "Arabic names: realism blends curated transliterated lists with procedural syllables; chain and classical (kunya, nasab, nisba, laqab) conventions; -family gulf|levantine|maghrebi transliterations; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p arabicProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the paired men's and women's titles (Sayyid/Sayyida,
+// Hajj/Hajja), used with the given name.
+func (p arabicProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sayyid", Gender: "male", Weight: 15, Use: api.UseGiven},
+			{Text: "Sayyida", Gender: "female", Weight: 15, Use: api.UseGiven},
+			{Text: "Sheikh", Gender: "male", Weight: 10, Use: api.UseGiven},
+			{Text: "Sheikha", Gender: "female", Weight: 8, Use: api.UseGiven},
+			{Text: "Ustadh", Gender: "male", Weight: 15, Use: api.UseGiven},
+			{Text: "Ustadha", Gender: "female", Weight: 15, Use: api.UseGiven},
+			{Text: "Hajj", Gender: "male", Weight: 10, Use: api.UseGiven},
+			{Text: "Hajja", Gender: "female", Weight: 10, Use: api.UseGiven},
+			{Text: "Duktur", Gender: "male", Weight: 5, Use: api.UseGiven},
+			{Text: "Duktura", Gender: "female", Weight: 5, Use: api.UseGiven},
+			{Text: "Dr.", Weight: 3},
+		},
+	}
+}
+
+// NicknameRules gives the curated familiar forms (Muhammad -> Hamada).
+func (p arabicProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated: nicknames,
 	}
 }
 
@@ -41,7 +81,7 @@ var firstNeutral = []string{
 }
 
 var lastNames = []string{
-	"Almasri", "Alharbi", "Alsayed", "Haddad", "Nassar", "Khatib", "Salem", "Farah", "Yousef", "Hamdan",
+	"al-Masri", "al-Harbi", "al-Sayed", "Haddad", "Nassar", "Khatib", "Salem", "Farah", "Yousef", "Hamdan",
 	"Abbas", "Khalil", "Mansour", "Najjar", "Amin", "Sharif", "Bakri", "Qasim", "Saeed", "Fahmy",
 	"Aziz", "Hussein", "Mahmoud", "Taha", "Darwish", "Sabbagh", "Zahran", "Fadel", "Ghanem", "Rashid",
 }
@@ -64,9 +104,104 @@ var codas = []string{"", "", "", "", "n", "r", "l", "d", "s", "m"}
 var givenEndings = []string{"", "", "", "a", "ah", "an", "in", "un", "i", "y"}
 var surnameEndings = []string{"", "", "", "i", "iy", "awi", "ani", "ari", "ullah", "uddin"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"muhammad": {"Hamada", "Mido"},
+	"ahmed":    {"Hamada", "Hamdi"},
+	"mahmoud":  {"Hoda", "Mimo"},
+	"mustafa":  {"Mossa", "Mostafa"},
+	"abdullah": {"Abdu", "Boody"},
+	"ibrahim":  {"Hima", "Bebo"},
+	"hassan":   {"Hasoon"},
+	"hussein":  {"Hoss"},
+	"yusuf":    {"Joe", "Yoyo"},
+	"ismail":   {"Sumsum"},
+	"khalid":   {"Khalood"},
+	"omar":     {"Omari", "Moro"},
+	"fatima":   {"Fatma", "Tuma", "Fafi"},
+	"aisha":    {"Shosho"},
+	"maryam":   {"Mimi", "Maryoom"},
+	"zainab":   {"Zozo", "Zeinab"},
+	"layla":    {"Lulu"},
+	"yasmin":   {"Yasso", "Mimi"},
+	"nadia":    {"Nody"},
+	"sara":     {"Soso"},
+	"ruqayya":  {"Roro"},
+	"huda":     {"Dodi"},
+}
+
+func genSyl(r api.RandLike) string {
+	// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel for variety.
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	// 2–4 syllables; lower realism sometimes 1–3
+	numSyl := 2 + r.Intn(3) // 2..4
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// soft ending
+	end := api.PickRand(givenEndings, r)
+	if end != "" && !strings.HasSuffix(b.String(), end) {
+		b.WriteString(end)
+	}
+
+	// slight gender bias at higher realism: more 'a/ah' endings for female,
+	// more 'i/in' endings for male (very light touch).
+	if realism >= 70 {
+		if cfg.Gender == "female" && r.Intn(100) < 20 {
+			s := b.String()
+			if !strings.HasSuffix(s, "a") && !strings.HasSuffix(s, "ah") {
+				b.WriteString("a")
+			}
+		}
+		if cfg.Gender == "male" && r.Intn(100) < 15 {
+			s := b.String()
+			if strings.HasSuffix(s, "a") {
+				b.Reset()
+				b.WriteString(strings.TrimSuffix(s, "a"))
+				b.WriteString("i")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	// 2–3 syllables
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// surname endings slightly more likely at higher realism
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+
+	return b.String()
+}
+
 func (p arabicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -95,122 +230,70 @@ func (p arabicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
 
-	genSyl := func() string {
-		// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel for variety.
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+	locale := resolveLocale(cfg.Family)
+	first := pickGiven(r, cfg, cfg.Gender, locale, realism, useRealPct)
+	if !cfg.IncludeLast {
+		return api.NameResult{First: first}, nil
 	}
 
-	genGivenProcedural := func() string {
-		// 2–4 syllables; lower realism sometimes 1–3
-		numSyl := 2 + r.Intn(3) // 2..4
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
+	convention := resolveConvention(cfg.Convention)
+	var ancestors []string
+	if convention != api.ConventionSurname {
+		for range resolveDepth(cfg.Depth) {
+			ancestors = append(ancestors, pickGiven(r, cfg, "male", locale, realism, useRealPct))
 		}
-
-		// soft ending
-		end := api.PickRand(givenEndings, r)
-		if end != "" && !strings.HasSuffix(b.String(), end) {
-			b.WriteString(end)
-		}
-
-		// slight gender bias at higher realism: more 'a/ah' endings for female,
-		// more 'i/in' endings for male (very light touch).
-		if realism >= 70 {
-			if cfg.Gender == "female" && r.Intn(100) < 20 {
-				s := b.String()
-				if !strings.HasSuffix(s, "a") && !strings.HasSuffix(s, "ah") {
-					b.WriteString("a")
-				}
-			}
-			if cfg.Gender == "male" && r.Intn(100) < 15 {
-				s := b.String()
-				if strings.HasSuffix(s, "a") {
-					b.Reset()
-					b.WriteString(strings.TrimSuffix(s, "a"))
-					b.WriteString("i")
-				}
-			}
-		}
-
-		return b.String()
 	}
 
-	genSurnameProcedural := func() string {
-		// 2–3 syllables
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// surname endings slightly more likely at higher realism
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
+	switch convention {
+	case api.ConventionChain:
+		// ism, father, grandfather ... and the family name, without connectors.
+		family := pickFamily(r, locale, realism, useRealPct)
+		parts := &api.NameParts{Locale: locale, Convention: convention, Ancestors: ancestors, Father: ancestors[0], SurnameBase: family}
+		return api.NameResult{First: first, Last: strings.Join(append(append([]string{}, ancestors...), family), " "), Parts: parts}, nil
 
-		return b.String()
+	case api.ConventionClassical:
+		parts := &api.NameParts{Locale: locale, Convention: convention, Ancestors: ancestors, Father: ancestors[0]}
+		son := spell(locale, api.PickRand(firstMale, r))
+		last, full := classical(r, regions[locale], cfg.Gender, first, ancestors, son, parts)
+		parts.Full = full
+		return api.NameResult{First: first, Last: last, Parts: parts}, nil
 	}
+	return api.NameResult{First: first, Last: pickFamily(r, locale, realism, useRealPct)}, nil
+}
 
-	// ---- First name selection ----
+// pickGiven picks a given name, curated in the region's spelling or
+// procedural.
+func pickGiven(r api.RandLike, cfg api.ProfileConfig, gender, locale string, realism, useRealPct int) string {
+	if !api.Chance(r, useRealPct) {
+		cfg.Gender = gender
+		return api.Title(genGivenProcedural(r, cfg, realism))
+	}
 	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
+	switch gender {
+	case "male":
+		first = api.PickRand(firstMale, r)
+	case "female":
+		first = api.PickRand(firstFemale, r)
+	default:
+		roll := r.Intn(100)
+		if roll < 55 {
+			first = api.PickRand(firstNeutral, r)
+		} else if roll < 78 {
 			first = api.PickRand(firstMale, r)
-		case "female":
-			first = api.PickRand(firstFemale, r)
-		default:
-			roll := r.Intn(100)
-			if roll < 55 {
-				first = api.PickRand(firstNeutral, r)
-			} else if roll < 78 {
-				first = api.PickRand(firstMale, r)
-			} else {
-				first = api.PickRand(firstFemale, r)
-			}
-		}
-	} else {
-		first = caser.String(genGivenProcedural())
-	}
-
-	// ---- Last name selection ----
-	last := ""
-	if cfg.IncludeLast {
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSurnameProcedural())
-			}
 		} else {
-			// If Family override is something else, still produce Arabic-ish surname for now.
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSurnameProcedural())
-			}
+			first = api.PickRand(firstFemale, r)
 		}
 	}
+	return spell(locale, first)
+}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+// pickFamily picks a family name, curated in the region's form or procedural.
+func pickFamily(r api.RandLike, locale string, realism, useRealPct int) string {
+	if api.Chance(r, useRealPct) {
+		return familyName(locale, api.PickRand(lastNames, r))
+	}
+	return api.Title(genSurnameProcedural(r, realism))
 }
 
 // Profile is the core exported symbol
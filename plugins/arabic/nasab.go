@@ -0,0 +1,201 @@
+package arabic
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Regional transliteration conventions, selected with cfg.Family.
+const (
+	localeStandard  = "standard"
+	localeGulf      = "gulf"
+	localeLevantine = "levantine"
+	localeMaghrebi  = "maghrebi"
+)
+
+var localeAliases = map[string]string{
+	"standard": localeStandard, "msa": localeStandard,
+	"gulf": localeGulf, "khaleeji": localeGulf, "saudi": localeGulf, "emirati": localeGulf, "kuwaiti": localeGulf, "qatari": localeGulf,
+	"levantine": localeLevantine, "levant": localeLevantine, "syrian": localeLevantine, "lebanese": localeLevantine,
+	"jordanian": localeLevantine, "palestinian": localeLevantine,
+	"maghrebi": localeMaghrebi, "maghreb": localeMaghrebi, "moroccan": localeMaghrebi, "algerian": localeMaghrebi,
+	"tunisian": localeMaghrebi,
+}
+
+// spellings gives the regional spelling of curated names that vary:
+// Mohammed in the Gulf, Mohammad in the Levant, Mohamed in the Maghreb.
+var spellings = map[string]map[string]string{
+	localeGulf: {
+		"Muhammad": "Mohammed", "Mahmoud": "Mahmood", "Hussein": "Hussain", "Yusuf": "Yousef", "Abdullah": "Abdulla",
+		"Zaid": "Zayed", "Aisha": "Aysha",
+	},
+	localeLevantine: {
+		"Muhammad": "Mohammad", "Ahmed": "Ahmad", "Yusuf": "Youssef", "Abdullah": "Abdallah", "Khalid": "Khaled",
+		"Tariq": "Tarek", "Maryam": "Mariam", "Layla": "Leila", "Noor": "Nour", "Zainab": "Zeinab", "Huda": "Houda",
+		"Yousef": "Youssef", "Qasim": "Kassem",
+	},
+	localeMaghrebi: {
+		"Muhammad": "Mohamed", "Hussein": "Houcine", "Yusuf": "Youssef", "Ibrahim": "Brahim", "Abdullah": "Abdellah",
+		"Khalid": "Khaled", "Mustafa": "Mustapha", "Tariq": "Tarik", "Jamal": "Djamel", "Faisal": "Faycal",
+		"Ismail": "Smail", "Aisha": "Aicha", "Maryam": "Meriem", "Layla": "Leila", "Noor": "Nour", "Zainab": "Zineb",
+		"Yasmin": "Yasmine", "Huda": "Houda", "Iman": "Imane", "Sumaya": "Soumaya", "Amin": "Amine",
+		"Yousef": "Youssef", "Saeed": "Said", "Qasim": "Kacem", "Rashid": "Rachid", "Sharif": "Cherif",
+	},
+}
+
+// conventions holds each region's particles: the article in family names
+// and nisbas, ibn/bint, and abu/umm of the kunya.
+type conventions struct {
+	surnameArticle string // joined to the family name root
+	article        string // before a nisba or laqab
+	son, daughter  string
+	father, mother string
+}
+
+var regions = map[string]conventions{
+	localeStandard:  {surnameArticle: "Al", article: "al-", son: "ibn", daughter: "bint", father: "Abu", mother: "Umm"},
+	localeGulf:      {surnameArticle: "Al ", article: "Al-", son: "bin", daughter: "bint", father: "Abu", mother: "Um"},
+	localeLevantine: {surnameArticle: "Al-", article: "al-", son: "ibn", daughter: "bint", father: "Abu", mother: "Umm"},
+	localeMaghrebi:  {surnameArticle: "El ", article: "El-", son: "ben", daughter: "bent", father: "Abou", mother: "Oum"},
+}
+
+// Nisbas (origin, tribe), written without the article.
+var nisbas = []string{
+	"Baghdadi", "Dimashqi", "Misri", "Andalusi", "Maghribi", "Hijazi", "Basri", "Kufi", "Qurashi", "Hashimi",
+	"Tamimi", "Ansari", "Halabi", "Shami", "Yamani", "Tunisi", "Fasi", "Qurtubi", "Bukhari", "Tabari",
+	"Isfahani", "Makki", "Madani", "Harbi", "Zahrani", "Otaibi", "Hadrami", "Sanani", "Maqdisi", "Iraqi",
+}
+
+// Laqabs (epithets); a leading "al-" takes the region's article.
+var laqabsMale = []string{
+	"al-Rashid", "al-Mansur", "al-Amin", "al-Hakim", "al-Sadiq", "al-Fadil", "al-Kabir", "al-Mahdi", "al-Hadi",
+	"Salah al-Din", "Nur al-Din", "Shams al-Din", "Izz al-Din", "Saif al-Dawla", "Imad al-Din",
+}
+
+var laqabsFemale = []string{
+	"al-Tahira", "al-Zahra", "al-Kubra", "al-Sughra", "al-Fadila", "Sitt al-Mulk", "Sitt al-Sham", "Nur al-Huda",
+}
+
+// Chances of the optional classical components, in percent.
+const (
+	kunyaPct = 40
+	laqabPct = 30
+)
+
+// defaultDepth is how many ancestors a chain names without cfg.Depth:
+// father and grandfather.
+const (
+	defaultDepth = 2
+	maxDepth     = 8
+)
+
+// spell puts a curated name into the region's transliteration.
+func spell(locale, name string) string {
+	if s, ok := spellings[locale][name]; ok {
+		return s
+	}
+	return name
+}
+
+// withArticle replaces a leading "al-" with the region's article.
+func withArticle(c conventions, s string) string {
+	if rest, ok := strings.CutPrefix(s, "al-"); ok {
+		return c.article + rest
+	}
+	return s
+}
+
+// familyName renders a curated family name: "al-Masri" becomes Almasri,
+// Al Masri, Al-Masri or El Masri by region; other names are only respelled.
+func familyName(locale, name string) string {
+	if rest, ok := strings.CutPrefix(name, "al-"); ok {
+		c := regions[locale]
+		if strings.HasSuffix(c.surnameArticle, " ") || strings.HasSuffix(c.surnameArticle, "-") {
+			return c.surnameArticle + spell(locale, rest)
+		}
+		return c.surnameArticle + strings.ToLower(spell(locale, rest))
+	}
+	return spell(locale, name)
+}
+
+// nisba is a nisba in the region's form, feminine for women
+// (al-Baghdadi, al-Baghdadiyya).
+func nisba(r api.RandLike, c conventions, gender string) string {
+	n := api.PickRand(nisbas, r)
+	if gender == "female" {
+		n += "yya"
+	}
+	return c.article + n
+}
+
+// classical fills the classical components into parts and returns the
+// display strings: Last (nasab and nisba) and Full (kunya, ism, laqab,
+// nasab, nisba).
+func classical(r api.RandLike, c conventions, gender, ism string, ancestors []string, son string, parts *api.NameParts) (last, full string) {
+	link := c.son
+	if gender == "female" {
+		link = c.daughter
+	}
+	var nasab []string
+	for i, a := range ancestors {
+		if i > 0 {
+			link = c.son // the chain continues through the fathers
+		}
+		nasab = append(nasab, link, a)
+	}
+	parts.Nisba = nisba(r, c, gender)
+	last = strings.Join(append(nasab, parts.Nisba), " ")
+
+	if api.Chance(r, kunyaPct) {
+		parent := c.father
+		if gender == "female" {
+			parent = c.mother
+		}
+		parts.Kunya = parent + " " + son
+	}
+	if api.Chance(r, laqabPct) {
+		laqabs := laqabsMale
+		if gender == "female" {
+			laqabs = laqabsFemale
+		}
+		parts.Laqab = withArticle(c, api.PickRand(laqabs, r))
+	}
+
+	var b []string
+	for _, s := range []string{parts.Kunya, ism, parts.Laqab, last} {
+		if s != "" {
+			b = append(b, s)
+		}
+	}
+	return last, strings.Join(b, " ")
+}
+
+// resolveLocale turns a cfg.Family value into a region, standard by default.
+func resolveLocale(family string) string {
+	if loc, ok := localeAliases[strings.ToLower(strings.TrimSpace(family))]; ok {
+		return loc
+	}
+	return localeStandard
+}
+
+// resolveConvention returns cfg.Convention when it applies here, otherwise
+// a given name and family name.
+func resolveConvention(convention string) string {
+	switch c := strings.ToLower(strings.TrimSpace(convention)); c {
+	case api.ConventionChain, api.ConventionClassical:
+		return c
+	}
+	return api.ConventionSurname
+}
+
+// resolveDepth clamps cfg.Depth, defaulting to father and grandfather.
+func resolveDepth(depth int) int {
+	switch {
+	case depth <= 0:
+		return defaultDepth
+	case depth > maxDepth:
+		return maxDepth
+	}
+	return depth
+}
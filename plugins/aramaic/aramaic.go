@@ -1,11 +1,11 @@
 package aramaic
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/semitic"
 )
 
 type aramaicProfile struct{}
@@ -19,7 +19,46 @@ func init() {
 func (p aramaicProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Aramaic/Syriac-inspired names (ASCII romanization): curated + procedural fallback; deterministic with seed",
+		"notes": "Aramaic/Syriac-inspired names (ASCII romanization), or in Syriac or Hebrew script with -script: curated, theophoric and procedural names; bar/barat patronymics",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p aramaicProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the Syriac church titles Mar and Marth and the teaching
+// titles, used with the given name.
+func (p aramaicProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Mar", Gender: "male", Weight: 30, Use: api.UseGiven},
+			{Text: "Marth", Gender: "female", Weight: 30, Use: api.UseGiven},
+			{Text: "Malpana", Gender: "male", Weight: 8, Use: api.UseGiven},
+			{Text: "Malpanitha", Gender: "female", Weight: 4, Use: api.UseGiven},
+			{Text: "Qashisha", Gender: "male", Weight: 4, Use: api.UseGiven},
+			{Text: "Rabbi", Gender: "male", Weight: 4, Use: api.UseGiven},
+		},
+	}
+}
+
+// NicknameRules shortens names to a stem ending in -o (Dozoamon
+// -> Dozo); there are no curated nicknames.
+func (p aramaicProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Suffixes: []string{"o"},
 	}
 }
 
@@ -39,10 +78,53 @@ var givenNeutral = []string{
 	"Shimon", "Yosef", "Hannah", "Maryam", "Eliya", "Natan", "Tamar", "Miriam", "Naomi", "Judith",
 }
 
-// Patronymic / clan-like / place-like endings (not truly “surnames” historically).
+// Family names: places of origin and the priestly lines. Patronymics (bar
+// Yosef, barat Yosef) are generated.
 var surnames = []string{
-	"Bar", "BarNatan", "BarYosef", "BarShimon", "Bethlehem", "Nazareth", "Ephesus", "Edessa", "Antioch", "Damascus",
-	"HaLevi", "Cohen",
+	"Bethlehem", "Nazareth", "Ephesus", "Edessa", "Antioch", "Damascus", "HaLevi", "Cohen",
+}
+
+// lexicon spells the curated names in the square script, which maps letter
+// for letter to Syriac (semitic.Syriac).
+var lexicon = semitic.Lexicon{
+	"Bartholomew": "ברתלמי", "Thomas": "תאומא", "Yohannan": "יוחנן", "Yeshua": "ישוע", "Shimon": "שמעון",
+	"Yosef": "יוסף", "Yaqub": "יעקוב", "Matthai": "מתי", "Taddeus": "תדי", "Philip": "פיליפוס",
+	"Andreas": "אנדראוס", "Petros": "פטרוס", "Paulos": "פולוס", "Barnaba": "ברנבא", "Hanania": "חנניא",
+	"Azaria": "עזריא", "Mishael": "מישאל", "Natan": "נתן", "Eliya": "אליא", "Gamaliel": "גמליאל",
+
+	"Maryam": "מרים", "Martha": "מרתא", "Hannah": "חנא", "Sarah": "סרא", "Rivqa": "רפקא",
+	"Leah": "ליא", "Rachel": "רחיל", "Elizabeth": "אלישבע", "Salome": "שלום", "Susanna": "שושן",
+	"Deborah": "דבורא", "Judith": "יהודית", "Tamar": "תמר", "Dinah": "דינא", "Esther": "אסתיר",
+	"Miriam": "מרים", "Naomi": "נועמי", "Abigail": "אביגיל", "Shifra": "שפרא", "Zipporah": "צפורא",
+
+	"Bethlehem": "בית לחם", "Nazareth": "נצרת", "Ephesus": "אפסוס", "Edessa": "אורהי",
+	"Antioch": "אנטיוכיא", "Damascus": "דרמשק", "HaLevi": "הלוי", "Cohen": "כהנא",
+}
+
+// theophory holds the divine elements and the roots they join. Women's
+// theophoric names are the few with a prefix (Elishba, Yohanna).
+var theophory = &semitic.Theophory{
+	Prefixes: []semitic.Name{{Roman: "El", Hebrew: "אל"}, {Roman: "Yeho", Hebrew: "יהו"}, {Roman: "Yo", Hebrew: "יו"}},
+	Suffixes: []semitic.Name{{Roman: "el", Hebrew: "אל"}, {Roman: "iah", Hebrew: "יא"}},
+	Roots: []semitic.Root{
+		{Pre: semitic.Name{Roman: "natan", Hebrew: "נתן"}, Post: semitic.Name{Roman: "natan", Hebrew: "נתנ"}},
+		{Pre: semitic.Name{Roman: "hanan", Hebrew: "חנן"}, Post: semitic.Name{Roman: "hanan", Hebrew: "חננ"}},
+		{Pre: semitic.Name{Roman: "azar", Hebrew: "עזר"}, Post: semitic.Name{Roman: "azar", Hebrew: "עזר"}},
+		{Pre: semitic.Name{Roman: "yakim", Hebrew: "יקים"}},
+		{Pre: semitic.Name{Roman: "ram", Hebrew: "רם"}, Only: "Yeho"},
+		{Post: semitic.Name{Roman: "zechar", Hebrew: "זכר"}, Only: "iah"},
+		{Post: semitic.Name{Roman: "uri", Hebrew: "אורי"}},
+		{Post: semitic.Name{Roman: "gavri", Hebrew: "גברי"}, Only: "el"},
+		{Post: semitic.Name{Roman: "micha", Hebrew: "מיכ"}},
+		{Post: semitic.Name{Roman: "nehem", Hebrew: "נחמ"}, Only: "iah"},
+		{Post: semitic.Name{Roman: "mattan", Hebrew: "מתנ"}, Only: "iah"},
+		{Post: semitic.Name{Roman: "tobi", Hebrew: "טובי"}, Only: "iah"},
+		{Post: semitic.Name{Roman: "gamali", Hebrew: "גמלי"}, Only: "el"},
+	},
+	FemaleRoots: []semitic.Root{
+		{Pre: semitic.Name{Roman: "ishba", Hebrew: "ישבע"}, Only: "El"},
+		{Pre: semitic.Name{Roman: "hanna", Hebrew: "חנא"}, Only: "Yo"},
+	},
 }
 
 // Procedural building blocks (Semitic-ish romanization, simplified).
@@ -69,153 +151,169 @@ var givenEndingsNeutral = []string{"", "", "", "a", "el", "on"}
 
 var surnameEndings = []string{"", "", "", "bar", "beth", "iya", "el", "an"}
 
-func (p aramaicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
-	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
-
-	realism := cfg.Realism
-	if realism < 0 {
-		realism = 0
-	}
-	if realism > 100 {
-		realism = 100
-	}
-
-	// same curve you’re using everywhere else
-	useRealPct := 0
-	switch {
-	case realism >= 95:
-		useRealPct = 95
-	case realism >= 90:
-		useRealPct = 90
-	case realism >= 80:
-		useRealPct = 80
-	case realism >= 70:
-		useRealPct = 55
-	case realism >= 60:
-		useRealPct = 35
-	case realism >= 40:
-		useRealPct = 20
-	default:
-		useRealPct = 5
+// Chances, in percent: that a curated given name is a theophoric compound
+// instead, that a procedural one takes a theophoric affix, and that a
+// curated family name is a patronymic.
+const (
+	theophoricPct = 20
+	affixPct      = 30
+	patronymicPct = 35
+)
+
+func genSyl(r api.RandLike) string {
+	// Semitic-ish CV(C) feel, with occasional vowel-start.
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
 
-	genSyl := func() string {
-		// Semitic-ish CV(C) feel, with occasional vowel-start.
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+// genRoot strings procedural syllables together; often 2-3.
+func genRoot(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
 	}
+	return b.String()
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	var b strings.Builder
+	b.WriteString(genRoot(r, realism))
 
-	genGivenProcedural := func() string {
-		// often 2-3 syllables
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3) // 1..3
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-		return b.String()
 	}
+	return b.String()
+}
 
-	genSurnameProcedural := func() string {
-		// 2-3 syllables, sometimes with a suffix.
-		n := 2 + r.Intn(2)
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	// 2-3 syllables, sometimes with a suffix.
+	n := 2 + r.Intn(2)
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
 
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-		return b.String()
 	}
+	return b.String()
+}
 
-	// ---- First (given) ----
-	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(givenMale, r)
-		case "female":
-			first = api.PickRand(givenFemale, r)
-		default:
-			roll := r.Intn(100)
-			if roll < 60 {
-				first = api.PickRand(givenNeutral, r)
-			} else if roll < 80 {
-				first = api.PickRand(givenMale, r)
-			} else {
-				first = api.PickRand(givenFemale, r)
-			}
+// procedural spells a procedural word as a name.
+func procedural(word string) semitic.Name {
+	name := api.Title(word)
+	return semitic.Name{Roman: name, Hebrew: semitic.Spell(name)}
+}
+
+// pickGiven picks a given name for gender: curated, a theophoric compound,
+// or procedural.
+func pickGiven(r api.RandLike, cfg api.ProfileConfig, gender string, realism, useRealPct int) semitic.Name {
+	if !api.Chance(r, useRealPct) {
+		if api.Chance(r, affixPct) {
+			return theophory.Affix(r, gender, procedural(genRoot(r, realism)))
 		}
-	} else {
-		first = caser.String(genGivenProcedural())
+		cfg.Gender = gender
+		return procedural(genGivenProcedural(r, cfg, realism))
+	}
+	if api.Chance(r, theophoricPct) {
+		return theophory.Compose(r, gender)
+	}
+	switch gender {
+	case "male":
+		return lexicon.Name(api.PickRand(givenMale, r))
+	case "female":
+		return lexicon.Name(api.PickRand(givenFemale, r))
 	}
+	roll := r.Intn(100)
+	if roll < 60 {
+		return lexicon.Name(api.PickRand(givenNeutral, r))
+	} else if roll < 80 {
+		return lexicon.Name(api.PickRand(givenMale, r))
+	}
+	return lexicon.Name(api.PickRand(givenFemale, r))
+}
+
+func (p aramaicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
+
+	// ---- First (given) ----
+	first := pickGiven(r, cfg, cfg.Gender, realism, useRealPct)
+	res := api.NameResult{First: first.Roman}
 
-	// ---- Last (surname/patronymic-ish) ----
-	last := ""
+	// ---- Last (patronymic or family name) ----
+	var last semitic.Name
 	if cfg.IncludeLast {
-		if chooseFromReal() {
-			// With some chance, treat "Bar X" as a patronymic.
-			if r.Intn(100) < 35 {
-				// "Bar" + (a given name)
-				var child string
-				switch cfg.Gender {
-				case "male":
-					child = api.PickRand(givenMale, r)
-				case "female":
-					child = api.PickRand(givenFemale, r)
-				default:
-					child = api.PickRand(givenNeutral, r)
-				}
-				last = "Bar" + caser.String(child)
-			} else {
-				last = api.PickRand(surnames, r)
-			}
-		} else {
-			last = caser.String(genSurnameProcedural())
+		real := api.Chance(r, useRealPct)
+		patronymic := real && api.Chance(r, patronymicPct)
+		switch strings.ToLower(strings.TrimSpace(cfg.Convention)) {
+		case api.ConventionPatronymic:
+			patronymic = true
+		case api.ConventionSurname:
+			patronymic = false
 		}
+		switch {
+		case patronymic:
+			// The father's name, with bar or barat by the child's gender.
+			father := pickGiven(r, cfg, "male", realism, useRealPct)
+			last = semitic.Aramaic.Patronymic(father, cfg.Gender)
+			res.Parts = &api.NameParts{Convention: api.ConventionPatronymic, Father: father.Roman}
+		case real:
+			last = lexicon.Name(api.PickRand(surnames, r))
+		default:
+			last = procedural(genSurnameProcedural(r, realism))
+		}
+		res.Last = last.Roman
+	}
+	if script := api.NativeScript(p, cfg); script != "" {
+		res.Native = semitic.Render(strings.TrimSpace(first.Hebrew+" "+last.Hebrew), script)
 	}
+	return res, nil
+}
+
+// Scripts lists the native scripts: Syriac, and the square script Jewish
+// Aramaic is written in.
+func (p aramaicProfile) Scripts() []string {
+	return []string{semitic.ScriptSyriac, semitic.ScriptHebrew}
+}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+// FormPatronymic lets family trees use the patronymic convention: bar
+// Yosef, barat Yosef.
+func (p aramaicProfile) FormPatronymic(parent string, _ bool, gender, _ string) string {
+	return semitic.Aramaic.Patronymic(lexicon.Name(parent), gender).Roman
 }
 
 var Profile aramaicProfile
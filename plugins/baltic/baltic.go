@@ -1,11 +1,10 @@
 package baltic
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type balticProfile struct{}
@@ -23,6 +22,44 @@ func (p balticProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p balticProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: slices.Concat(maleSurnameEndings, surnameEndingsNeutral),
+	}
+}
+
+// Forms gives the Lithuanian Ponas/Ponia and Latvian Kungs/Kundze.
+func (p balticProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Ponas", Gender: "male", Weight: 30},
+			{Text: "Ponia", Gender: "female", Weight: 30},
+			{Text: "Kungs", Gender: "male", Weight: 20},
+			{Text: "Kundze", Gender: "female", Weight: 20},
+			{Text: "Dr.", Weight: 4},
+		},
+	}
+}
+
+// NicknameRules adds the Lithuanian diminutives -ukas for men and -ute for
+// women; there are no curated nicknames.
+func (p balticProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		MaleSuffixes:   []string{"ukas"},
+		FemaleSuffixes: []string{"ute"},
+	}
+}
+
 // Curated (ASCII; no diacritics).
 var givenMale = []string{
 	"Jonas", "Marius", "Tomas", "Darius", "Mindaugas", "Vytautas", "Paulius", "Andrius", "Rokas", "Lukas",
@@ -38,14 +75,14 @@ var givenNeutral = []string{
 	"Ruta", "Lina", "Laura", "Monika", "Simona", "Tomas", "Lukas", "Rokas", "Marius", "Greta",
 }
 
-// Curated Baltic-ish surnames (ASCII).
+// Curated Baltic-ish surnames (ASCII, dictionary form); see morphology.go for
+// the per-locale lists.
 var surnames = []string{
 	"Kazlauskas", "Petrauskas", "Jankauskas", "Stankevicius", "Zukauskas", "Vaitkus", "Butkus", "Kavaliauskas",
 	"Berzins", "Kalnins", "Ozols", "Liepa", "Jansons", "Krumins", "Balodis",
 }
 
 var maleSurnameEndings = []string{"as", "is", "us", "aitis", "enas", "onis"}
-var femaleSurnameEndings = []string{"a", "e", "iene", "yte", "aite", "ute"}
 
 // Procedural blocks
 var onsets = []string{
@@ -70,9 +107,63 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "as", "is", "us", "a", "e", "ius"}
 var surnameEndingsNeutral = []string{"", "", "", "as", "is", "us", "ins", "aus", "aitis"}
 
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	n := 2
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	} else if r.Intn(100) < 25 {
+		n = 2 + r.Intn(2)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 55 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// add a surname ending sometimes (dictionary form; InflectSurname
+	// derives the feminine forms)
+	if r.Intn(100) < 70 {
+		switch cfg.Gender {
+		case "male", "female":
+			b.WriteString(api.PickRand(maleSurnameEndings, r))
+		default:
+			b.WriteString(api.PickRand(surnameEndingsNeutral, r))
+		}
+	}
+	return b.String()
+}
+
+// genLocaleSurname builds a dictionary-form surname with a sub-locale ending.
+func genLocaleSurname(r api.RandLike, locale string) string {
+	n := 1 + r.Intn(2) // 1..2, the ending adds one more
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	b.WriteString(api.PickRand(localeEndings[locale], r))
+	return b.String()
+}
+
 func (p balticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -99,55 +190,9 @@ func (p balticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 2
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		} else if r.Intn(100) < 25 {
-			n = 2 + r.Intn(2)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 55 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// add a gender-ish surname ending sometimes
-		if r.Intn(100) < 70 {
-			switch cfg.Gender {
-			case "male":
-				b.WriteString(api.PickRand(maleSurnameEndings, r))
-			case "female":
-				b.WriteString(api.PickRand(femaleSurnameEndings, r))
-			default:
-				b.WriteString(api.PickRand(surnameEndingsNeutral, r))
-			}
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -164,19 +209,50 @@ func (p balticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
+	// Surnames are picked in dictionary form and inflected for gender by
+	// sub-locale (cfg.Family: lithuanian, latvian). Lithuanian women get the
+	// married form or, less often, the maiden form.
 	last := ""
+	var parts *api.NameParts
 	if cfg.IncludeLast {
-		if chooseFromReal() {
-			last = api.PickRand(surnames, r)
+		base := ""
+		locale := resolveLocale(cfg.Family)
+		if locale != "" {
+			if api.Chance(r, useRealPct) {
+				base = api.PickRand(localeSurnames[locale], r)
+			} else {
+				base = api.Title(genLocaleSurname(r, locale))
+			}
 		} else {
-			last = caser.String(genSurnameProcedural())
+			if api.Chance(r, useRealPct) {
+				base = api.PickRand(surnames, r)
+			} else {
+				base = api.Title(genSurnameProcedural(r, cfg, realism))
+			}
+			locale = inferLocale(base)
+		}
+
+		var form api.SurnameForm
+		switch cfg.Gender {
+		case "male":
+			form = api.SurnameMasculine
+		case "female":
+			form = api.SurnameFeminine
+			if locale == localeLithuanian {
+				form = api.SurnameMarried
+				if api.Chance(r, 40) {
+					form = api.SurnameMaiden
+				}
+			}
 		}
+		last = p.InflectSurname(base, form, locale)
+		parts = &api.NameParts{Locale: locale, SurnameBase: base, SurnameForm: form}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last), Parts: parts}, nil
 }
 
 var Profile balticProfile
@@ -0,0 +1,142 @@
+package baltic
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Sub-locales, selected with cfg.Family. Without one the locale is inferred
+// from each surname.
+const (
+	localeLithuanian = "lt"
+	localeLatvian    = "lv"
+)
+
+var localeAliases = map[string]string{
+	"lt": localeLithuanian, "lithuanian": localeLithuanian, "lithuania": localeLithuanian,
+	"lv": localeLatvian, "latvian": localeLatvian, "latvia": localeLatvian,
+}
+
+// Curated surnames per sub-locale, in their dictionary (masculine) form.
+var localeSurnames = map[string][]string{
+	localeLithuanian: {
+		"Kazlauskas", "Petrauskas", "Jankauskas", "Stankevicius", "Zukauskas", "Vaitkus", "Butkus", "Kavaliauskas",
+		"Paulauskas", "Urbonas", "Navickas", "Ramanauskas", "Savickas", "Rimkus", "Baranauskas", "Petraitis",
+		"Sakalauskas", "Vasiliauskas", "Adomaitis", "Mazeika",
+	},
+	localeLatvian: {
+		"Berzins", "Kalnins", "Ozols", "Liepa", "Jansons", "Krumins", "Balodis", "Zarins", "Ozolins", "Vitols",
+		"Klavins", "Karklins", "Petersons", "Vanags", "Lacis", "Kalejs", "Grinbergs", "Strazds", "Eglitis", "Apinis",
+	},
+}
+
+// Procedural surname endings per sub-locale (dictionary form).
+var localeEndings = map[string][]string{
+	localeLithuanian: {"as", "as", "is", "us", "ys", "ius", "aitis", "enas", "auskas"},
+	localeLatvian:    {"is", "ins", "ins", "ans", "ons", "ums", "ars", "els", "itis"},
+}
+
+// surnameLocale maps each curated surname to its sub-locale.
+var surnameLocale = func() map[string]string {
+	m := map[string]string{}
+	for loc, list := range localeSurnames {
+		for _, s := range list {
+			m[strings.ToLower(s)] = loc
+		}
+	}
+	return m
+}()
+
+// resolveLocale turns a cfg.Family value into a sub-locale ("" if none).
+func resolveLocale(family string) string {
+	return localeAliases[strings.ToLower(strings.TrimSpace(family))]
+}
+
+// inferLocale guesses the sub-locale of a surname: the curated lists first,
+// then Lithuanian for its -as/-ys/-us/-is endings and Latvian for other
+// consonant + s endings.
+func inferLocale(surname string) string {
+	s := strings.ToLower(surname)
+	if loc, ok := surnameLocale[s]; ok {
+		return loc
+	}
+	switch {
+	case hasAnySuffix(s, "as", "ys", "us", "is"):
+		return localeLithuanian
+	case strings.HasSuffix(s, "s"):
+		return localeLatvian
+	}
+	return localeLithuanian
+}
+
+// InflectSurname puts a dictionary-form surname into form. Lithuanian
+// distinguishes a married woman's form (Kazlauskas -> Kazlauskiene) from a
+// maiden form (Kazlauskaite, Petraityte, Butkute, Mazeikaite); a plain
+// feminine request gets the married form. Latvian feminine surnames swap
+// the ending (Berzins -> Berzina, Jansons -> Jansone, Balodis -> Balode).
+func (p balticProfile) InflectSurname(base string, form api.SurnameForm, locale string) string {
+	if base == "" || form == "" || form == api.SurnameMasculine {
+		return base
+	}
+	if loc := resolveLocale(locale); loc != "" {
+		locale = loc
+	} else {
+		locale = inferLocale(base)
+	}
+	s := strings.ToLower(base)
+	stem := func(n int) string { return base[:len(base)-n] }
+
+	if locale == localeLatvian {
+		switch {
+		case hasAnySuffix(s, "sons"):
+			return stem(1) + "e"
+		case hasAnySuffix(s, "is"):
+			return stem(2) + "e"
+		case hasAnySuffix(s, "us"):
+			return base
+		case hasAnySuffix(s, "s"):
+			return stem(1) + "a"
+		}
+		return base
+	}
+
+	maiden := form == api.SurnameMaiden
+	switch {
+	case hasAnySuffix(s, "ius"):
+		if maiden {
+			return stem(3) + "iute"
+		}
+		return stem(3) + "iene"
+	case hasAnySuffix(s, "as"):
+		if maiden {
+			return stem(2) + "aite"
+		}
+		return stem(2) + "iene"
+	case hasAnySuffix(s, "is", "ys"):
+		if maiden {
+			return stem(2) + "yte"
+		}
+		return stem(2) + "iene"
+	case hasAnySuffix(s, "us"):
+		if maiden {
+			return stem(2) + "ute"
+		}
+		return stem(2) + "iene"
+	case hasAnySuffix(s, "a"):
+		if maiden {
+			return stem(1) + "aite"
+		}
+		return stem(1) + "iene"
+	}
+	return base
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suf := range suffixes {
+		if strings.HasSuffix(s, suf) {
+			return true
+		}
+	}
+	return false
+}
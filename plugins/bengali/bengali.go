@@ -0,0 +1,112 @@
+package bengali
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/indic"
+)
+
+type bengaliProfile struct{}
+
+const PROFILE = "bengali"
+
+func init() {
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p bengaliProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Bengali names (romanized, ASCII): curated + shared Indic phonotactics; conventions surname, initials, patronymic, caste-neutral; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p bengaliProfile) Inventory() api.Inventory {
+	return region.Inventory()
+}
+
+// Forms gives Sri and Srimati, and Babu after a man's given name.
+func (p bengaliProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sri", Gender: "male", Weight: 40},
+			{Text: "Srimati", Gender: "female", Weight: 30},
+			{Text: "Babu", Gender: "male", Weight: 10, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Dr.", Weight: 8},
+		},
+	}
+}
+
+// NicknameRules gives curated daknam (pet names) and the familiar endings
+// -u, -da (brother) for men and -di (sister) for women.
+func (p bengaliProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:        nicknames,
+		Suffixes:       []string{"u"},
+		MaleSuffixes:   []string{"da"},
+		FemaleSuffixes: []string{"di"},
+	}
+}
+
+// FormPatronymic lets family trees use the patronymic convention.
+func (p bengaliProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	return region.FormPatronymic(parent, matronymic, gender, locale)
+}
+
+var firstMale = []string{
+	"Arindam", "Sourav", "Debashis", "Partha", "Subhash", "Anirban", "Rabindra", "Sayan", "Tapan", "Abhijit",
+	"Indranil", "Prosenjit", "Arnab", "Somnath", "Kaushik", "Sandip", "Amitava", "Sudipto", "Biswajit", "Ritwik",
+}
+
+var firstFemale = []string{
+	"Ananya", "Rituparna", "Moumita", "Sharmila", "Sreya", "Paromita", "Debjani", "Rupa", "Madhumita", "Tanusree",
+	"Sudeshna", "Aparna", "Mousumi", "Chandrima", "Sohini", "Payel", "Rimjhim", "Koel", "Mitali", "Swastika",
+}
+
+var firstNeutral = []string{
+	"Joy", "Rishi", "Shubho", "Mithu", "Tuhin", "Bubai", "Rumi", "Shanto",
+}
+
+var lastNames = []string{
+	"Banerjee", "Chatterjee", "Mukherjee", "Ganguly", "Bhattacharya", "Bose", "Ghosh", "Sen", "Das", "Dutta",
+	"Roy", "Chakraborty", "Sarkar", "Mitra", "Guha", "Majumdar", "Biswas", "Pal", "Saha", "Mondal",
+}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"sourav":    {"Dada"},
+	"arindam":   {"Arin"},
+	"debashis":  {"Debu"},
+	"subhash":   {"Subho"},
+	"abhijit":   {"Abhi"},
+	"madhumita": {"Madhu", "Mou"},
+	"ananya":    {"Anu"},
+	"rituparna": {"Ritu"},
+	"sharmila":  {"Mila"},
+	"chandrima": {"Chandu"},
+}
+
+var region = indic.Region{
+	Male: firstMale, Female: firstFemale, Neutral: firstNeutral,
+	Surnames:      lastNames,
+	InitialFather: true,
+
+	// No v (written b), sw and shr clusters; -ashis, -endu and -jit for
+	// men, -mita, -ila and -shree for women, -jee surnames.
+	Onsets: []string{
+		"", "",
+		"b", "bh", "d", "dh", "g", "k", "kh", "m", "n", "p", "r",
+		"s", "sh", "t", "th", "j", "ch", "h", "l", "sw", "shr", "pr",
+	},
+	MaleEndings:    []string{"", "", "", "ashis", "endu", "ajit", "ab", "on"},
+	FemaleEndings:  []string{"", "", "", "mita", "ila", "shree", "ashi", "arna"},
+	NeutralEndings: []string{"", "", "", "on", "i", "a"},
+	SurnameEndings: []string{"", "", "jee", "erjee", "i"},
+}
+
+func (p bengaliProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return region.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile bengaliProfile
@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type celticProfile struct{}
@@ -23,6 +21,53 @@ func (p celticProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p celticProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the Irish and British titles and the Gaelic Og and Mor
+// ("the younger", "the elder") along with Jr.
+func (p celticProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Mr", Gender: "male", Weight: 50},
+			{Text: "Mrs", Gender: "female", Weight: 15},
+			{Text: "Ms", Gender: "female", Weight: 30},
+			{Text: "Miss", Gender: "female", Weight: 5},
+			{Text: "Mx", Gender: "neutral", Weight: 10},
+			{Text: "Dr", Weight: 6},
+			{Text: "Sir", Gender: "male", Weight: 1, Use: api.UseGiven},
+			{Text: "Dame", Gender: "female", Weight: 1, Use: api.UseGiven},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "Og", Gender: "male", Weight: 20},
+			{Text: "Jr.", Gender: "male", Weight: 10},
+			{Text: "Mor", Gender: "male", Weight: 5},
+		},
+		SuffixPct: 4,
+	}
+}
+
+// NicknameRules gives curated short forms and the endings -ie, -een and -an.
+func (p celticProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"ie", "een", "an"},
+	}
+}
+
 // Curated: common Irish/Scottish/Welsh given names (ASCII only; no accents).
 var givenMale = []string{
 	"Sean", "Liam", "Conor", "Ciaran", "Eoin", "Niall", "Fionn", "Declan", "Ronan", "Cormac",
@@ -73,9 +118,57 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "an", "en", "in", "on", "ach", "aidh", "wyn", "wen"}
 var surnameEndings = []string{"", "", "", "son", "ley", "lan", "nan", "don", "more", "ford"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"seamus":   {"Shay"},
+	"padraig":  {"Paddy", "Podge"},
+	"eoin":     {"Eoinie"},
+	"sean":     {"Seanie"},
+	"diarmuid": {"Dermot", "Diarmo"},
+	"ciaran":   {"Kieran"},
+	"siobhan":  {"Shiv"},
+	"aoife":    {"Eva"},
+	"caoimhe":  {"Keeva"},
+	"niamh":    {"Neevy"},
+	"mairead":  {"Mags"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 40 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 45 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p celticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -102,45 +195,10 @@ func (p celticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 40 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 45 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
 	// First
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -157,13 +215,13 @@ func (p celticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
 	// Last
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			// Some chance to fabricate a patronymic: Prefix + CuratedSurname (no punctuation)
 			if r.Intn(100) < 35 {
 				pfx := api.PickRand(patronymicPrefixes, r)
@@ -175,11 +233,11 @@ func (p celticProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 				last = api.PickRand(surnames, r)
 			}
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = api.Title(genSurnameProcedural(r, realism))
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile celticProfile
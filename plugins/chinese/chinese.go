@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type chineseProfile struct{}
@@ -19,54 +17,203 @@ func init() {
 func (p chineseProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Chinese names (pinyin): realism blends curated lists with procedural pinyin syllables; deterministic with seed",
+		"notes": "Chinese names: realism blends curated lists with procedural syllables from the Mandarin syllable table; romanizations pinyin (default), pinyin-tones, wade-giles, jyutping; Hanzi with script native",
 	}
 }
 
-// Curated pinyin given names (no tone marks for simplicity).
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p chineseProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    firstMale,
+		FirstFemale:  firstFemale,
+		FirstNeutral: firstNeutral,
+		Last:         lastNames,
+		NeutralMixes: true,
+		Nuclei:       inventorySpellings(),
+	}
+}
+
+// Forms gives the titles that follow the surname (Wang Xiansheng).
+func (p chineseProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Xiansheng", Gender: "male", Weight: 50, After: true, Join: " "},
+			{Text: "Nüshi", Gender: "female", Weight: 40, After: true, Join: " "},
+			{Text: "Xiaojie", Gender: "female", Weight: 10, After: true, Join: " "},
+			{Text: "Laoshi", Weight: 8, After: true, Join: " "},
+			{Text: "Yisheng", Weight: 3, After: true, Join: " "},
+		},
+	}
+}
+
+// NicknameRules puts Xiao or A before a one-syllable stem or the whole
+// name, and doubles one-syllable names (Lingling); there are no curated
+// nicknames.
+func (p chineseProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Prefixes:    []string{"Xiao", "A"},
+		Codas:       []string{"n", "ng", "r"},
+		Reduplicate: true,
+		NoClip:      true,
+	}
+}
+
+// Curated given names in Hanzi; see hanzi for their readings.
 var firstMale = []string{
-	"Wei", "Jie", "Jun", "Hao", "Ming", "Lei", "Qiang", "Bo", "Chen", "Feng",
-	"Yu", "Peng", "Tao", "Yang", "Bin", "Guang", "Dong", "Chao", "Gang", "Sheng",
-	"Zhi", "Heng", "Xiang", "Rui", "Yong", "Xuan", "Yifan", "Haoran", "Zhe", "Yuze",
+	"伟", "杰", "俊", "浩", "明", "磊", "强", "波", "晨", "峰",
+	"宇", "鹏", "涛", "阳", "斌", "光", "东", "超", "刚", "胜",
+	"志", "恒", "翔", "睿", "勇", "轩", "一凡", "浩然", "哲", "宇泽",
 }
 
 var firstFemale = []string{
-	"Mei", "Ling", "Yan", "Na", "Jing", "Xiu", "Hua", "Fang", "Ying", "Li",
-	"Juan", "Min", "Qian", "Xue", "Xia", "Lan", "Ting", "Rong", "Xin", "Shan",
-	"Yutong", "Yihan", "Zihan", "Ruoxi", "Xinyi", "Jia", "Yue", "Yuxi", "Kexin", "Meng",
+	"梅", "玲", "燕", "娜", "静", "秀", "华", "芳", "英", "丽",
+	"娟", "敏", "倩", "雪", "霞", "兰", "婷", "蓉", "欣", "珊",
+	"雨桐", "一涵", "子涵", "若曦", "欣怡", "佳", "悦", "雨汐", "可欣", "梦",
 }
 
 var firstNeutral = []string{
-	"Wei", "Yu", "Rui", "Xin", "Jia", "Yue", "Ming", "Yang", "Lin", "An",
+	"维", "宇", "睿", "欣", "佳", "悦", "明", "阳", "林", "安",
 }
 
-// Curated pinyin surnames (common family names).
+// Curated surnames (common family names).
 var lastNames = []string{
-	"Wang", "Li", "Zhang", "Liu", "Chen", "Yang", "Huang", "Zhao", "Wu", "Zhou",
-	"Xu", "Sun", "Ma", "Zhu", "Hu", "Guo", "He", "Gao", "Lin", "Luo",
-	"Zheng", "Liang", "Xie", "Song", "Tang", "Han", "Feng", "Yu", "Dong", "Xiao",
+	"王", "李", "张", "刘", "陈", "杨", "黄", "赵", "吴", "周",
+	"徐", "孙", "马", "朱", "胡", "郭", "何", "高", "林", "罗",
+	"郑", "梁", "谢", "宋", "唐", "韩", "冯", "于", "董", "萧",
+}
+
+// Romanization systems (cfg.Romanization).
+const (
+	romanPinyin      = "pinyin"       // toneless Hanyu Pinyin, the default: Li Ming
+	romanPinyinTones = "pinyin-tones" // with tone marks: Lǐ Míng
+	romanWadeGiles   = "wade-giles"   // Li Ming, Ch'en Hao-jan
+	romanJyutping    = "jyutping"     // Cantonese readings, toneless: Lei Ming
+)
+
+var romanAliases = map[string]string{
+	"pinyin": romanPinyin, "hanyu": romanPinyin,
+	"pinyin-tones": romanPinyinTones, "tones": romanPinyinTones, "tone-marks": romanPinyinTones,
+	"wade-giles": romanWadeGiles, "wade": romanWadeGiles, "wg": romanWadeGiles,
+	"jyutping": romanJyutping, "cantonese": romanJyutping,
+}
+
+// Scripts lists the native script: Hanzi.
+func (p chineseProfile) Scripts() []string {
+	return []string{"hanzi"}
+}
+
+// Romanizations lists the romanization systems, pinyin first.
+func (p chineseProfile) Romanizations() []string {
+	return []string{romanPinyin, romanPinyinTones, romanWadeGiles, romanJyutping}
+}
+
+// name is a given name or surname as syllables, with its Hanzi and
+// Jyutping when it was built from characters.
+type name struct {
+	hanzi    string
+	syls     []syllable
+	jyutping []string
+}
+
+// fromHanzi reads a curated name character by character.
+func fromHanzi(s string) name {
+	n := name{hanzi: s}
+	for _, c := range s {
+		rd := hanzi[string(c)]
+		syl, _ := parseReading(rd.pinyin)
+		n.syls = append(n.syls, syl)
+		n.jyutping = append(n.jyutping, strings.TrimRight(rd.jyutping, "123456"))
+	}
+	return n
+}
+
+// romanize spells n in the given system. Pinyin runs syllables together
+// (with an apostrophe before a, o or e: Xi'an); Wade-Giles and Jyutping
+// hyphenate them (Hao-jan).
+func (n name) romanize(romanization string) string {
+	var b strings.Builder
+	for i, s := range n.syls {
+		switch romanization {
+		case romanWadeGiles, romanJyutping:
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			if romanization == romanJyutping && i < len(n.jyutping) {
+				b.WriteString(n.jyutping[i])
+			} else {
+				b.WriteString(s.wadeGiles())
+			}
+		default:
+			p := s.pinyin()
+			// Test the toneless spelling: a toned vowel is more than one byte.
+			if i > 0 && strings.ContainsAny(p[:1], "aoe") {
+				b.WriteByte('\'')
+			}
+			if romanization == romanPinyinTones {
+				p = s.pinyinTones()
+			}
+			b.WriteString(p)
+		}
+	}
+	return capitalize(b.String())
+}
+
+func genSyllable(r api.RandLike) syllable {
+	s := syllables[r.Intn(len(syllables))]
+	// Avoid a bare "er" too often.
+	if s.initial == "" && s.final == "er" && r.Intn(100) < 70 {
+		s.final = "e"
+	}
+	s.tone = 1 + r.Intn(4)
+	return s
 }
 
-// Pinyin syllable building blocks (simplified, no tones).
-var initials = []string{
-	"", "", "b", "p", "m", "f", "d", "t", "n", "l",
-	"g", "k", "h", "j", "q", "x", "zh", "ch", "sh", "r",
-	"z", "c", "s", "y", "w",
+// givenLength is the number of syllables in a procedural given name: many
+// given names are 2 syllables; allow 1 sometimes.
+func givenLength(r api.RandLike, realism int) int {
+	n := 2
+	if realism < 40 {
+		if r.Intn(100) < 35 {
+			n = 1
+		}
+	} else {
+		if r.Intn(100) < 15 {
+			n = 1
+		}
+	}
+	return n
 }
 
-var finals = []string{
-	"a", "ai", "an", "ang", "ao",
-	"e", "ei", "en", "eng", "er",
-	"i", "ia", "ian", "iang", "iao", "ie", "in", "ing", "iong", "iu",
-	"o", "ong", "ou",
-	"u", "ua", "uai", "uan", "uang", "ui", "un", "uo",
-	"v", "ve", "van", "vn", // represent ü as v
+func genGivenProcedural(r api.RandLike, realism int) name {
+	var n name
+	for i := givenLength(r, realism); i > 0; i-- {
+		n.syls = append(n.syls, genSyllable(r))
+	}
+	return n
+}
+
+// genGivenHanzi builds a given name from name characters, for output that
+// needs Hanzi.
+func genGivenHanzi(r api.RandLike, gender string, realism int) name {
+	pool, ok := givenChars[gender]
+	if !ok {
+		pool = givenChars["neutral"]
+	}
+	var b strings.Builder
+	for i := givenLength(r, realism); i > 0; i-- {
+		b.WriteString(api.PickRand(pool, r))
+	}
+	return fromHanzi(b.String())
 }
 
 // Common two-syllable given-name patterns are frequent; we keep optional 1-syllable too.
 func (p chineseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	romanization := resolveRomanization(cfg.Romanization)
+	native := api.NativeScript(p, cfg) != ""
+	// Hanzi and Jyutping need real characters, so procedural names are then
+	// composed from name characters instead of free syllables.
+	needHanzi := native || romanization == romanJyutping
 
 	// clamp realism
 	realism := cfg.Realism
@@ -95,102 +242,73 @@ func (p chineseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyllable := func() string {
-		ini := api.PickRand(initials, r)
-		fin := api.PickRand(finals, r)
-		// Avoid impossible/awkward combos (very light filtering).
-		// e.g., 'y' or 'w' often stand in for i/u glides; keep them only with certain finals.
-		if (ini == "y" || ini == "w") && strings.HasPrefix(fin, "v") {
-			ini = ""
-		}
-		// Avoid empty+er too often
-		if ini == "" && fin == "er" && r.Intn(100) < 70 {
-			fin = "e"
-		}
-		return ini + fin
-	}
-
-	genGivenProcedural := func() string {
-		// Many given names are 2 syllables; allow 1 sometimes.
-		n := 2
-		if realism < 40 {
-			if r.Intn(100) < 35 {
-				n = 1
-			}
-		} else {
-			if r.Intn(100) < 15 {
-				n = 1
-			}
-		}
-
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyllable())
-		}
-		return b.String()
-	}
 
 	// ---- Given name selection ----
-	first := ""
-	if chooseFromReal() {
+	var given name
+	switch {
+	case api.Chance(r, useRealPct):
 		switch cfg.Gender {
 		case "male":
-			first = api.PickRand(firstMale, r)
+			given = fromHanzi(api.PickRand(firstMale, r))
 		case "female":
-			first = api.PickRand(firstFemale, r)
+			given = fromHanzi(api.PickRand(firstFemale, r))
 		default:
 			roll := r.Intn(100)
 			if roll < 60 {
-				first = api.PickRand(firstNeutral, r)
+				given = fromHanzi(api.PickRand(firstNeutral, r))
 			} else if roll < 80 {
-				first = api.PickRand(firstMale, r)
+				given = fromHanzi(api.PickRand(firstMale, r))
 			} else {
-				first = api.PickRand(firstFemale, r)
+				given = fromHanzi(api.PickRand(firstFemale, r))
 			}
 		}
-	} else {
-		first = caser.String(genGivenProcedural())
+	case needHanzi:
+		given = genGivenHanzi(r, cfg.Gender, realism)
+	default:
+		given = genGivenProcedural(r, realism)
 	}
 
 	// ---- Surname selection ----
-	last := ""
+	var surname name
 	if cfg.IncludeLast {
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				// Procedural surname: 1 syllable is most common; sometimes 2 for variety.
-				n := 1
-				if realism < 40 {
-					if r.Intn(100) < 10 {
-						n = 2
-					}
-				} else {
-					if r.Intn(100) < 5 {
-						n = 2
-					}
+		switch {
+		case api.Chance(r, useRealPct):
+			surname = fromHanzi(api.PickRand(lastNames, r))
+		case needHanzi:
+			surname = fromHanzi(api.PickRand(moreSurnames, r))
+		default:
+			// Procedural surname: 1 syllable is most common; sometimes 2 for variety.
+			n := 1
+			if realism < 40 {
+				if r.Intn(100) < 10 {
+					n = 2
 				}
-				var b strings.Builder
-				for i := 0; i < n; i++ {
-					b.WriteString(genSyllable())
+			} else {
+				if r.Intn(100) < 5 {
+					n = 2
 				}
-				last = caser.String(b.String())
 			}
-		} else {
-			// If Family override is something else, still produce Chinese-ish surname for now.
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSyllable())
+			for i := 0; i < n; i++ {
+				surname.syls = append(surname.syls, genSyllable(r))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+	res := api.NameResult{First: given.romanize(romanization), Last: surname.romanize(romanization)}
+	if native {
+		// Surname first, no space: 李明.
+		res.Native = surname.hanzi + given.hanzi
+	}
+	return res, nil
+}
+
+// resolveRomanization turns a cfg.Romanization value into a system, pinyin
+// by default.
+func resolveRomanization(romanization string) string {
+	if r, ok := romanAliases[strings.ToLower(strings.TrimSpace(romanization))]; ok {
+		return r
+	}
+	return romanPinyin
 }
 
 // Profile is the core exported symbol
@@ -0,0 +1,91 @@
+package chinese
+
+// reading is how a character used in names is read: numbered Mandarin
+// pinyin and Cantonese Jyutping (toneless in output).
+type reading struct {
+	pinyin, jyutping string
+}
+
+// hanzi gives the name reading of every character in the curated lists and
+// character pools.
+var hanzi = map[string]reading{
+	// Surnames.
+	"王": {"wang2", "wong4"}, "李": {"li3", "lei5"}, "张": {"zhang1", "zoeng1"}, "刘": {"liu2", "lau4"},
+	"陈": {"chen2", "can4"}, "杨": {"yang2", "joeng4"}, "黄": {"huang2", "wong4"}, "赵": {"zhao4", "ziu6"},
+	"吴": {"wu2", "ng4"}, "周": {"zhou1", "zau1"}, "徐": {"xu2", "ceoi4"}, "孙": {"sun1", "syun1"},
+	"马": {"ma3", "maa5"}, "朱": {"zhu1", "zyu1"}, "胡": {"hu2", "wu4"}, "郭": {"guo1", "gwok3"},
+	"何": {"he2", "ho4"}, "高": {"gao1", "gou1"}, "林": {"lin2", "lam4"}, "罗": {"luo2", "lo4"},
+	"郑": {"zheng4", "zeng6"}, "梁": {"liang2", "loeng4"}, "谢": {"xie4", "ze6"}, "宋": {"song4", "sung3"},
+	"唐": {"tang2", "tong4"}, "韩": {"han2", "hon4"}, "冯": {"feng2", "fung4"}, "于": {"yu2", "jyu1"},
+	"董": {"dong3", "dung2"}, "萧": {"xiao1", "siu1"},
+	"叶": {"ye4", "jip6"}, "曾": {"zeng1", "zang1"}, "彭": {"peng2", "paang4"}, "吕": {"lü3", "leoi5"},
+	"苏": {"su1", "sou1"}, "卢": {"lu2", "lou4"}, "蒋": {"jiang3", "zoeng2"}, "蔡": {"cai4", "coi3"},
+	"贾": {"jia3", "gaa2"}, "丁": {"ding1", "ding1"}, "魏": {"wei4", "ngai6"}, "薛": {"xue1", "sit3"},
+	"余": {"yu2", "jyu4"}, "潘": {"pan1", "pun1"}, "杜": {"du4", "dou6"}, "戴": {"dai4", "daai3"},
+	"夏": {"xia4", "haa6"}, "钟": {"zhong1", "zung1"}, "汪": {"wang1", "wong1"}, "田": {"tian2", "tin4"},
+	"任": {"ren2", "jam4"}, "姜": {"jiang1", "goeng1"}, "范": {"fan4", "faan6"}, "方": {"fang1", "fong1"},
+	"石": {"shi2", "sek6"}, "姚": {"yao2", "jiu4"}, "谭": {"tan2", "taam4"}, "廖": {"liao4", "liu6"},
+	"邹": {"zou1", "zau1"}, "熊": {"xiong2", "hung4"}, "金": {"jin1", "gam1"}, "陆": {"lu4", "luk6"},
+	"郝": {"hao3", "kok3"}, "孔": {"kong3", "hung2"}, "白": {"bai2", "baak6"}, "崔": {"cui1", "ceoi1"},
+	"康": {"kang1", "hong1"}, "毛": {"mao2", "mou4"}, "邱": {"qiu1", "jau1"}, "秦": {"qin2", "ceon4"},
+	"江": {"jiang1", "gong1"}, "史": {"shi3", "si2"}, "顾": {"gu4", "gu3"}, "侯": {"hou2", "hau4"},
+	"邵": {"shao4", "siu6"}, "孟": {"meng4", "maang6"}, "万": {"wan4", "maan6"}, "段": {"duan4", "dyun6"},
+	"雷": {"lei2", "leoi4"}, "钱": {"qian2", "cin4"}, "汤": {"tang1", "tong1"}, "尹": {"yin3", "wan5"},
+	"黎": {"li2", "lai4"}, "易": {"yi4", "ji6"}, "常": {"chang2", "soeng4"}, "武": {"wu3", "mou5"},
+	"乔": {"qiao2", "kiu4"}, "贺": {"he4", "ho6"}, "赖": {"lai4", "laai6"}, "龚": {"gong1", "gung1"},
+	"欧": {"ou1", "au1"}, "司": {"si1", "si1"}, "诸": {"zhu1", "zyu1"}, "葛": {"ge3", "got3"},
+
+	// Given-name characters.
+	"伟": {"wei3", "wai5"}, "杰": {"jie2", "git6"}, "俊": {"jun4", "zeon3"}, "浩": {"hao4", "hou6"},
+	"明": {"ming2", "ming4"}, "磊": {"lei3", "leoi5"}, "强": {"qiang2", "koeng4"}, "波": {"bo1", "bo1"},
+	"晨": {"chen2", "san4"}, "峰": {"feng1", "fung1"}, "宇": {"yu3", "jyu5"}, "鹏": {"peng2", "paang4"},
+	"涛": {"tao1", "tou4"}, "阳": {"yang2", "joeng4"}, "斌": {"bin1", "ban1"}, "光": {"guang1", "gwong1"},
+	"东": {"dong1", "dung1"}, "超": {"chao1", "ciu1"}, "刚": {"gang1", "gong1"}, "胜": {"sheng4", "sing3"},
+	"志": {"zhi4", "zi3"}, "恒": {"heng2", "hang4"}, "翔": {"xiang2", "coeng4"}, "睿": {"rui4", "jeoi6"},
+	"勇": {"yong3", "jung5"}, "轩": {"xuan1", "hin1"}, "一": {"yi1", "jat1"}, "凡": {"fan2", "faan4"},
+	"然": {"ran2", "jin4"}, "哲": {"zhe2", "zit3"}, "泽": {"ze2", "zaak6"},
+	"梅": {"mei2", "mui4"}, "玲": {"ling2", "ling4"}, "燕": {"yan4", "jin3"}, "娜": {"na4", "naa4"},
+	"静": {"jing4", "zing6"}, "秀": {"xiu4", "sau3"}, "华": {"hua2", "waa4"}, "芳": {"fang1", "fong1"},
+	"英": {"ying1", "jing1"}, "丽": {"li4", "lai6"}, "娟": {"juan1", "gyun1"}, "敏": {"min3", "man5"},
+	"倩": {"qian4", "sin3"}, "雪": {"xue3", "syut3"}, "霞": {"xia2", "haa4"}, "兰": {"lan2", "laan4"},
+	"婷": {"ting2", "ting4"}, "蓉": {"rong2", "jung4"}, "欣": {"xin1", "jan1"}, "珊": {"shan1", "saan1"},
+	"雨": {"yu3", "jyu5"}, "桐": {"tong2", "tung4"}, "涵": {"han2", "haam4"}, "子": {"zi3", "zi2"},
+	"若": {"ruo4", "joek6"}, "曦": {"xi1", "hei1"}, "怡": {"yi2", "ji4"}, "佳": {"jia1", "gaai1"},
+	"悦": {"yue4", "jyut6"}, "汐": {"xi1", "zik6"}, "可": {"ke3", "ho2"}, "梦": {"meng4", "mung6"},
+	"维": {"wei2", "wai4"}, "安": {"an1", "on1"},
+	"文": {"wen2", "man4"}, "建": {"jian4", "gin3"}, "国": {"guo2", "gwok3"}, "平": {"ping2", "ping4"},
+	"海": {"hai3", "hoi2"}, "天": {"tian1", "tin1"}, "龙": {"long2", "lung4"}, "云": {"yun2", "wan4"},
+	"思": {"si1", "si1"}, "嘉": {"jia1", "gaa1"}, "晓": {"xiao3", "hiu2"}, "春": {"chun1", "ceon1"},
+	"秋": {"qiu1", "cau1"}, "德": {"de2", "dak1"}, "永": {"yong3", "wing5"}, "新": {"xin1", "san1"},
+	"宁": {"ning2", "ning4"}, "清": {"qing1", "cing1"}, "成": {"cheng2", "sing4"}, "博": {"bo2", "bok3"},
+	"诗": {"shi1", "si1"}, "琪": {"qi2", "kei4"}, "慧": {"hui4", "wai6"}, "洁": {"jie2", "git3"},
+	"红": {"hong2", "hung4"}, "玉": {"yu4", "juk6"}, "晶": {"jing1", "zing1"},
+}
+
+// Characters drawn for procedural given names when the name needs Hanzi
+// (native script or Jyutping).
+var givenChars = map[string][]string{
+	"male": {
+		"伟", "杰", "俊", "浩", "明", "磊", "强", "波", "晨", "峰", "宇", "鹏", "涛", "阳", "斌", "光",
+		"东", "超", "刚", "胜", "志", "恒", "翔", "睿", "勇", "轩", "凡", "然", "哲", "泽", "文", "建",
+		"国", "平", "海", "天", "龙", "云", "德", "永", "成", "博", "子", "一", "嘉", "新",
+	},
+	"female": {
+		"梅", "玲", "燕", "娜", "静", "秀", "华", "芳", "英", "丽", "娟", "敏", "倩", "雪", "霞", "兰",
+		"婷", "蓉", "欣", "珊", "雨", "桐", "涵", "若", "曦", "怡", "佳", "悦", "汐", "可", "梦", "诗",
+		"琪", "慧", "洁", "红", "玉", "晶", "思", "晓", "春", "子", "一", "嘉",
+	},
+	"neutral": {
+		"明", "宇", "睿", "欣", "佳", "悦", "阳", "林", "安", "维", "文", "宁", "清", "思", "晓", "嘉",
+		"子", "一", "雨", "新", "天", "云", "春", "秋",
+	},
+}
+
+// Rarer surnames, drawn instead of procedural syllables when the name needs
+// Hanzi.
+var moreSurnames = []string{
+	"叶", "曾", "彭", "吕", "苏", "卢", "蒋", "蔡", "贾", "丁", "魏", "薛", "余", "潘", "杜", "戴",
+	"夏", "钟", "汪", "田", "任", "姜", "范", "方", "石", "姚", "谭", "廖", "邹", "熊", "金", "陆",
+	"郝", "孔", "白", "崔", "康", "毛", "邱", "秦", "江", "史", "顾", "侯", "邵", "孟", "万", "段",
+	"雷", "钱", "汤", "尹", "黎", "易", "常", "武", "乔", "贺", "赖", "龚", "欧阳", "司马", "诸葛",
+}
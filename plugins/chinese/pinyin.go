@@ -0,0 +1,229 @@
+package chinese
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// syllable is a Mandarin syllable: initial ("" for none), final in its
+// full form ("ü", "üe", "ui", "un"; the apical vowel of zhi/zi is "i") and
+// tone 1-4 (5 is the neutral tone).
+type syllable struct {
+	initial, final string
+	tone           int
+}
+
+// finalInitials lists, per final, the initials it combines with; "" is the
+// zero initial (spelled with y/w). This is the standard Mandarin syllable
+// table, about 400 syllables without tones.
+var finalInitials = []struct {
+	final    string
+	initials []string
+}{
+	{"a", []string{"", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "z", "c", "s"}},
+	{"o", []string{"", "b", "p", "m", "f"}},
+	{"e", []string{"", "m", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"ai", []string{"", "b", "p", "m", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "z", "c", "s"}},
+	{"ei", []string{"", "b", "p", "m", "f", "d", "n", "l", "g", "h", "z", "sh"}},
+	{"ao", []string{"", "b", "p", "m", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"ou", []string{"", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"an", []string{"", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"en", []string{"", "b", "p", "m", "f", "d", "n", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"ang", []string{"", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"eng", []string{"b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"ong", []string{"d", "t", "n", "l", "g", "k", "h", "zh", "ch", "r", "z", "c", "s"}},
+	{"er", []string{""}},
+	{"i", []string{"", "b", "p", "m", "d", "t", "n", "l", "j", "q", "x", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"ia", []string{"", "l", "j", "q", "x"}},
+	{"ie", []string{"", "b", "p", "m", "d", "t", "n", "l", "j", "q", "x"}},
+	{"iao", []string{"", "b", "p", "m", "d", "t", "n", "l", "j", "q", "x"}},
+	{"iu", []string{"", "m", "d", "n", "l", "j", "q", "x"}},
+	{"ian", []string{"", "b", "p", "m", "d", "t", "n", "l", "j", "q", "x"}},
+	{"in", []string{"", "b", "p", "m", "n", "l", "j", "q", "x"}},
+	{"iang", []string{"", "n", "l", "j", "q", "x"}},
+	{"ing", []string{"", "b", "p", "m", "d", "t", "n", "l", "j", "q", "x"}},
+	{"iong", []string{"", "j", "q", "x"}},
+	{"u", []string{"", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"ua", []string{"", "g", "k", "h", "zh", "sh"}},
+	{"uo", []string{"", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"uai", []string{"", "g", "k", "h", "zh", "ch", "sh"}},
+	{"ui", []string{"", "d", "t", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"uan", []string{"", "d", "t", "n", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"un", []string{"", "d", "t", "l", "g", "k", "h", "zh", "ch", "sh", "r", "z", "c", "s"}},
+	{"uang", []string{"", "g", "k", "h", "zh", "ch", "sh"}},
+	{"ueng", []string{""}},
+	{"ü", []string{"", "n", "l", "j", "q", "x"}},
+	{"üe", []string{"", "n", "l", "j", "q", "x"}},
+	{"üan", []string{"", "j", "q", "x"}},
+	{"ün", []string{"", "j", "q", "x"}},
+}
+
+// syllables is the toneless syllable inventory; spelled maps each pinyin
+// spelling back to its syllable.
+var syllables, spelled = buildInventory()
+
+func buildInventory() ([]syllable, map[string]syllable) {
+	var list []syllable
+	index := map[string]syllable{}
+	for _, f := range finalInitials {
+		for _, ini := range f.initials {
+			s := syllable{initial: ini, final: f.final}
+			list = append(list, s)
+			index[s.pinyin()] = s
+		}
+	}
+	return list, index
+}
+
+// Zero-initial spellings, where y/w stand in for the medial.
+var zeroInitial = map[string]string{
+	"i": "yi", "ia": "ya", "ie": "ye", "iao": "yao", "iu": "you", "ian": "yan", "in": "yin",
+	"iang": "yang", "ing": "ying", "iong": "yong",
+	"u": "wu", "ua": "wa", "uo": "wo", "uai": "wai", "ui": "wei", "uan": "wan", "un": "wen",
+	"uang": "wang", "ueng": "weng",
+	"ü": "yu", "üe": "yue", "üan": "yuan", "ün": "yun",
+}
+
+// pinyin spells s without tone marks: "ü" stays after n and l (nü, lüe) and
+// is written u after j, q, x and y.
+func (s syllable) pinyin() string {
+	if s.initial == "" {
+		if z, ok := zeroInitial[s.final]; ok {
+			return z
+		}
+		return s.final
+	}
+	final := s.final
+	switch s.initial {
+	case "j", "q", "x":
+		final = strings.ReplaceAll(final, "ü", "u")
+	}
+	return s.initial + final
+}
+
+var toneMarks = map[rune][4]rune{
+	'a': {'ā', 'á', 'ǎ', 'à'},
+	'e': {'ē', 'é', 'ě', 'è'},
+	'i': {'ī', 'í', 'ǐ', 'ì'},
+	'o': {'ō', 'ó', 'ǒ', 'ò'},
+	'u': {'ū', 'ú', 'ǔ', 'ù'},
+	'ü': {'ǖ', 'ǘ', 'ǚ', 'ǜ'},
+}
+
+// pinyinTones spells s with its tone mark: on a or e, on the o of ou, and
+// otherwise on the last vowel ("liú", "guì").
+func (s syllable) pinyinTones() string {
+	p := s.pinyin()
+	if s.tone < 1 || s.tone > 4 {
+		return p
+	}
+	at := -1
+	switch {
+	case strings.ContainsRune(p, 'a'):
+		at = strings.IndexRune(p, 'a')
+	case strings.ContainsRune(p, 'e'):
+		at = strings.IndexRune(p, 'e')
+	case strings.Contains(p, "ou"):
+		at = strings.Index(p, "ou")
+	default:
+		at = strings.LastIndexAny(p, "iouü")
+	}
+	if at < 0 {
+		return p
+	}
+	v, size := utf8.DecodeRuneInString(p[at:])
+	return p[:at] + string(toneMarks[v][s.tone-1]) + p[at+size:]
+}
+
+var wadeInitials = map[string]string{
+	"b": "p", "p": "p'", "d": "t", "t": "t'", "g": "k", "k": "k'",
+	"j": "ch", "q": "ch'", "x": "hs", "zh": "ch", "ch": "ch'", "r": "j",
+	"z": "ts", "c": "ts'",
+}
+
+var wadeZeroInitial = map[string]string{
+	"e": "o", "er": "erh", "i": "i", "ia": "ya", "ie": "yeh", "iao": "yao", "iu": "yu", "ian": "yen",
+	"in": "yin", "iang": "yang", "ing": "ying", "iong": "yung",
+	"u": "wu", "ua": "wa", "uo": "wo", "uai": "wai", "ui": "wei", "uan": "wan", "un": "wen",
+	"uang": "wang", "ueng": "weng",
+	"ü": "yü", "üe": "yüeh", "üan": "yüan", "ün": "yün",
+}
+
+var wadeFinals = map[string]string{
+	"e": "ê", "ie": "ieh", "ian": "ien", "ong": "ung", "iong": "iung", "üe": "üeh",
+}
+
+// wadeGiles spells s in Wade-Giles, without tone numbers: "Ch'en", "Hsü",
+// "Tzu", "Kuo".
+func (s syllable) wadeGiles() string {
+	if s.initial == "" {
+		if w, ok := wadeZeroInitial[s.final]; ok {
+			return w
+		}
+		return s.final
+	}
+	if s.final == "i" {
+		switch s.initial {
+		case "z":
+			return "tzu"
+		case "c":
+			return "tz'u"
+		case "s":
+			return "ssu"
+		case "zh", "ch", "sh", "r":
+			return wadeInitial(s.initial) + "ih"
+		}
+	}
+	final := s.final
+	switch {
+	case final == "e" && (s.initial == "g" || s.initial == "k" || s.initial == "h"):
+		final = "o"
+	case final == "ui" && (s.initial == "g" || s.initial == "k"):
+		final = "uei"
+	case final == "uo" && s.initial != "g" && s.initial != "k" && s.initial != "h" && s.initial != "sh":
+		final = "o"
+	default:
+		if w, ok := wadeFinals[final]; ok {
+			final = w
+		}
+	}
+	return wadeInitial(s.initial) + final
+}
+
+func wadeInitial(initial string) string {
+	if w, ok := wadeInitials[initial]; ok {
+		return w
+	}
+	return initial
+}
+
+// parseReading parses numbered pinyin ("lü3", "ming2") into a syllable.
+func parseReading(reading string) (syllable, bool) {
+	tone := 5
+	if n := len(reading); n > 0 && reading[n-1] >= '1' && reading[n-1] <= '5' {
+		tone = int(reading[n-1] - '0')
+		reading = reading[:n-1]
+	}
+	s, ok := spelled[reading]
+	s.tone = tone
+	return s, ok
+}
+
+// capitalize upper-cases the first letter of a romanized name.
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// inventorySpellings lists the toneless pinyin of every syllable.
+func inventorySpellings() []string {
+	out := make([]string, len(syllables))
+	for i, s := range syllables {
+		out[i] = s.pinyin()
+	}
+	return out
+}
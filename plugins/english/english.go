@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type englishProfile struct{}
@@ -23,6 +21,57 @@ func (p englishProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p englishProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         consonants,
+		Nuclei:         vowels,
+		Codas:          consonants,
+		SurnameEndings: surnameSuffixes,
+	}
+}
+
+// Forms gives the English titles and the generational suffixes Jr., Sr.
+// and II to IV.
+func (p englishProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Mr.", Gender: "male", Weight: 50},
+			{Text: "Mrs.", Gender: "female", Weight: 15},
+			{Text: "Ms.", Gender: "female", Weight: 30},
+			{Text: "Miss", Gender: "female", Weight: 8},
+			{Text: "Mx.", Gender: "neutral", Weight: 20},
+			{Text: "Dr.", Weight: 8},
+			{Text: "Prof.", Weight: 2},
+			{Text: "Rev.", Weight: 1},
+			{Text: "Sir", Gender: "male", Weight: 1, Use: api.UseGiven},
+			{Text: "Dame", Gender: "female", Weight: 1, Use: api.UseGiven},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "Jr.", Gender: "male", Weight: 50},
+			{Text: "Sr.", Gender: "male", Weight: 20},
+			{Text: "II", Gender: "male", Weight: 8},
+			{Text: "III", Gender: "male", Weight: 12},
+			{Text: "IV", Gender: "male", Weight: 3},
+		},
+		SuffixPct: 12,
+	}
+}
+
+// NicknameRules gives curated nicknames (William -> Bill) and the -y/-ie
+// diminutives of longer names.
+func (p englishProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"y", "ie"},
+	}
+}
+
 // Small curated lists (expand anytime).
 // Intentionally mixed: classic + modern + neutral-ish.
 var firstMale = []string{
@@ -52,6 +101,12 @@ var lastNames = []string{
 	"Green", "Adams", "Nelson", "Baker", "Hall", "Rivera", "Campbell", "Mitchell", "Carter", "Roberts",
 }
 
+// Procedural building blocks (kept from the original approach)
+var vowels = []string{"a", "e", "i", "o", "u"}
+var consonants = []string{"b", "c", "d", "f", "g", "h", "j", "k", "l", "m", "n", "p", "r", "s", "t", "v", "w", "y", "z"}
+var realFragments = []string{"el", "ric", "mar", "an", "beth", "ron", "ly", "ton", "den", "ley", "gar", "wyn", "la", "li", "jo", "na", "mi", "sa"}
+var surnameSuffixes = []string{"son", "ford", "wood", "well", "shire", "field", "stone", "brook"}
+
 // Conservative mutation: small “English-feeling” tweaks for variety.
 // Only applied at higher realism and low probability.
 func mutateEnglish(r api.RandLike, s string) string {
@@ -115,82 +170,129 @@ func mutateEnglish(r api.RandLike, s string) string {
 	return lower
 }
 
-func (p englishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
-	r := api.NewRand(cfg)
-	caser := cases.Title(language.English)
-
-	// Procedural building blocks (kept from your original approach)
-	vowels := []string{"a", "e", "i", "o", "u"}
-	consonants := []string{"b", "c", "d", "f", "g", "h", "j", "k", "l", "m", "n", "p", "r", "s", "t", "v", "w", "y", "z"}
-	realFragments := []string{"el", "ric", "mar", "an", "beth", "ron", "ly", "ton", "den", "ley", "gar", "wyn", "la", "li", "jo", "na", "mi", "sa"}
-
-	genSyl := func(pat string) string {
-		var b strings.Builder
-		for _, ch := range pat {
-			if ch == 'C' {
-				b.WriteString(api.PickRand(consonants, r))
-			} else {
-				b.WriteString(api.PickRand(vowels, r))
-			}
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"james":     {"Jim", "Jimmy", "Jamie"},
+	"john":      {"Jack", "Johnny"},
+	"robert":    {"Bob", "Rob", "Bobby", "Robbie"},
+	"michael":   {"Mike", "Mikey", "Mick"},
+	"william":   {"Bill", "Will", "Liam", "Billy"},
+	"david":     {"Dave", "Davy"},
+	"richard":   {"Rick", "Rich", "Richie"},
+	"joseph":    {"Joe", "Joey"},
+	"thomas":    {"Tom", "Tommy"},
+	"charles":   {"Charlie", "Chuck", "Chas"},
+	"daniel":    {"Dan", "Danny"},
+	"matthew":   {"Matt", "Matty"},
+	"anthony":   {"Tony", "Ant"},
+	"steven":    {"Steve", "Stevie"},
+	"andrew":    {"Andy", "Drew"},
+	"joshua":    {"Josh"},
+	"kevin":     {"Kev"},
+	"nathan":    {"Nate", "Nat"},
+	"benjamin":  {"Ben", "Benny", "Benji"},
+	"henry":     {"Harry", "Hank", "Hal"},
+	"jack":      {"Jackie"},
+	"oliver":    {"Ollie", "Noll"},
+	"aaron":     {"Ron", "Ronnie"},
+	"jason":     {"Jay"},
+	"eric":      {"Rick"},
+	"mary":      {"Molly", "Polly", "Mae"},
+	"patricia":  {"Pat", "Patty", "Trish", "Tricia"},
+	"jennifer":  {"Jen", "Jenny"},
+	"elizabeth": {"Liz", "Beth", "Lizzie", "Betsy", "Eliza"},
+	"barbara":   {"Barb", "Babs"},
+	"susan":     {"Sue", "Susie"},
+	"jessica":   {"Jess", "Jessie"},
+	"margaret":  {"Maggie", "Peggy", "Meg", "Margie"},
+	"sandra":    {"Sandy"},
+	"kimberly":  {"Kim", "Kimmy"},
+	"rebecca":   {"Becky", "Becca"},
+	"stephanie": {"Steph"},
+	"amanda":    {"Mandy"},
+	"melissa":   {"Mel", "Missy"},
+	"michelle":  {"Shelly"},
+	"olivia":    {"Liv", "Livvy"},
+	"sophia":    {"Sophie"},
+	"isabella":  {"Bella", "Izzy", "Isa"},
+	"amelia":    {"Millie", "Amy"},
+	"alex":      {"Al", "Lex"},
+	"cameron":   {"Cam"},
+	"jordan":    {"Jordy"},
+	"samuel":    {"Sam", "Sammy"},
+	"nancy":     {"Nan"},
+	"emily":     {"Em", "Emmy"},
+}
+
+func genSyl(r api.RandLike, pat string) string {
+	var b strings.Builder
+	for _, ch := range pat {
+		if ch == 'C' {
+			b.WriteString(api.PickRand(consonants, r))
+		} else {
+			b.WriteString(api.PickRand(vowels, r))
 		}
-		return b.String()
 	}
+	return b.String()
+}
 
-	genProceduralFirst := func() string {
-		// syllables influenced by realism and gender
-		numSyl := 1 + r.Intn(3)
-		if cfg.Realism > 70 {
-			numSyl = 2 + r.Intn(2)
-		}
-		first := ""
-		for i := 0; i < numSyl; i++ {
-			pat := "CV"
-			if cfg.Gender == "male" {
-				if r.Intn(100) < 40 {
-					pat = "CVC"
-				}
-			} else if cfg.Gender == "female" {
-				if r.Intn(100) < 30 {
-					pat = "V"
-				}
-			} else {
-				if r.Intn(100) < 30 {
-					pat = "CVC"
-				}
+func genProceduralFirst(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	// syllables influenced by realism and gender
+	numSyl := 1 + r.Intn(3)
+	if cfg.Realism > 70 {
+		numSyl = 2 + r.Intn(2)
+	}
+	first := ""
+	for i := 0; i < numSyl; i++ {
+		pat := "CV"
+		if cfg.Gender == "male" {
+			if r.Intn(100) < 40 {
+				pat = "CVC"
 			}
-			// realism: inject fragments sometimes
-			if cfg.Realism > 60 && r.Intn(100) < cfg.Realism/2 {
-				first += api.PickRand(realFragments, r)
-			} else {
-				first += genSyl(pat)
+		} else if cfg.Gender == "female" {
+			if r.Intn(100) < 30 {
+				pat = "V"
+			}
+		} else {
+			if r.Intn(100) < 30 {
+				pat = "CVC"
 			}
 		}
-		return first
+		// realism: inject fragments sometimes
+		if cfg.Realism > 60 && r.Intn(100) < cfg.Realism/2 {
+			first += api.PickRand(realFragments, r)
+		} else {
+			first += genSyl(r, pat)
+		}
 	}
+	return first
+}
 
-	genProceduralLast := func() string {
-		last := ""
-		parts := 1 + r.Intn(2)
-		for i := 0; i < parts; i++ {
-			last += genSyl("CVC")
+func genProceduralLast(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	last := ""
+	parts := 1 + r.Intn(2)
+	for i := 0; i < parts; i++ {
+		last += genSyl(r, "CVC")
+	}
+	// suffixes — make less aggressive at high realism
+	if cfg.Family == PROFILE || cfg.Family == "" {
+		roll := r.Intn(100)
+		// At realism 100, allow suffix sometimes, but not constantly.
+		threshold := 20
+		if cfg.Realism < 60 {
+			threshold = 60
+		} else if cfg.Realism < 80 {
+			threshold = 40
 		}
-		// suffixes — make less aggressive at high realism
-		if cfg.Family == PROFILE || cfg.Family == "" {
-			roll := r.Intn(100)
-			// At realism 100, allow suffix sometimes, but not constantly.
-			threshold := 20
-			if cfg.Realism < 60 {
-				threshold = 60
-			} else if cfg.Realism < 80 {
-				threshold = 40
-			}
-			if roll < threshold {
-				suffs := []string{"son", "ford", "wood", "well", "shire", "field", "stone", "brook"}
-				last += api.PickRand(suffs, r)
-			}
+		if roll < threshold {
+			last += api.PickRand(surnameSuffixes, r)
 		}
-		return last
 	}
+	return last
+}
+
+func (p englishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
 
 	// --- Realism blending strategy ---
 	// realism in [0..100]
@@ -222,11 +324,9 @@ func (p englishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 		useRealPct = 5
 	}
 
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
 	// First name selection
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -244,16 +344,16 @@ func (p englishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			}
 		}
 	} else {
-		first = caser.String(genProceduralFirst())
+		first = api.Title(genProceduralFirst(r, cfg, realism))
 	}
 
 	// Last name selection
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(lastNames, r)
 		} else {
-			last = caser.String(genProceduralLast())
+			last = api.Title(genProceduralLast(r, cfg, realism))
 		}
 	}
 
@@ -277,8 +377,8 @@ func (p englishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	}
 
 	// Ensure proper casing if we generated procedurally
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 
 	return api.NameResult{First: first, Last: last}, nil
 }
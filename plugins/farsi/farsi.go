@@ -1,11 +1,10 @@
 package farsi
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type farsiProfile struct{}
@@ -23,6 +22,43 @@ func (p farsiProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p farsiProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Agha-ye before a man's name and Khanom after a woman's
+// given name.
+func (p farsiProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Agha-ye", Gender: "male", Weight: 50},
+			{Text: "Khanom", Gender: "female", Weight: 50, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Doktor", Weight: 6},
+			{Text: "Ostad", Weight: 4},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms and the endings -i and -jan.
+func (p farsiProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"i", "jan"},
+	}
+}
+
 // Curated given names (romanized; ASCII only).
 var firstMale = []string{
 	"Ali", "Reza", "Mohammad", "Hossein", "Mehdi", "Amir", "Saeed", "Morteza", "Hassan", "Javad",
@@ -63,9 +99,79 @@ var givenEndingsNeutral = []string{"", "", "", "a", "an", "in"}
 
 var surnameEndings = []string{"", "", "", "i", "ian", "zadeh", "pour", "nejad"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"mohammad":     {"Mamad", "Mamali"},
+	"ali":          {"Alireza"},
+	"mahmoud":      {"Mamoud"},
+	"hossein":      {"Hosi"},
+	"mohammadreza": {"Mamadreza"},
+	"fatemeh":      {"Fati"},
+	"zahra":        {"Zari"},
+	"maryam":       {"Mari"},
+	"parisa":       {"Pari"},
+	"shirin":       {"Shiri"},
+}
+
+func genSyl(r api.RandLike) string {
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2)
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2)
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+	return b.String()
+}
+
 func (p farsiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -92,67 +198,9 @@ func (p farsiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2)
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2)
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -169,20 +217,20 @@ func (p farsiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(lastNames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = api.Title(genSurnameProcedural(r, realism))
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type filipinoProfile struct{}
@@ -23,6 +21,58 @@ func (p filipinoProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p filipinoProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the Tagalog and English titles, Kuya and Ate with the given
+// name, and the Jr./Sr./II/III suffixes common in the Philippines.
+func (p filipinoProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "G.", Gender: "male", Weight: 20},
+			{Text: "Gng.", Gender: "female", Weight: 15},
+			{Text: "Bb.", Gender: "female", Weight: 10},
+			{Text: "Mr.", Gender: "male", Weight: 20},
+			{Text: "Ms.", Gender: "female", Weight: 15},
+			{Text: "Kuya", Gender: "male", Weight: 8, Use: api.UseGiven},
+			{Text: "Ate", Gender: "female", Weight: 8, Use: api.UseGiven},
+			{Text: "Dr.", Weight: 4},
+			{Text: "Atty.", Weight: 3},
+			{Text: "Engr.", Weight: 3},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "Jr.", Gender: "male", Weight: 60},
+			{Text: "Sr.", Gender: "male", Weight: 15},
+			{Text: "III", Gender: "male", Weight: 10},
+			{Text: "II", Gender: "male", Weight: 5},
+		},
+		SuffixPct: 15,
+	}
+}
+
+// NicknameRules gives curated nicknames (Jose -> Jojo), the endings -ing and
+// -oy, and doubled stems.
+func (p filipinoProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:     nicknames,
+		Suffixes:    []string{"ing", "oy"},
+		Reduplicate: true,
+	}
+}
+
 // Curated given names commonly used in the Philippines (mix of Tagalog, Spanish, and modern).
 var firstMale = []string{
 	"Juan", "Jose", "Antonio", "Miguel", "Andres", "Ramon", "Ricardo", "Eduardo", "Fernando", "Manuel",
@@ -62,9 +112,89 @@ var codas = []string{"", "", "", "", "n", "ng", "s", "r", "t"}
 var givenEndings = []string{"", "", "", "a", "o", "i", "an", "en", "in"}
 var surnameEndings = []string{"", "", "", "son", "san", "dez", "ez", "ano", "ista"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"jose":      {"Pepe", "Peping", "Jojo"},
+	"juan":      {"Juanito", "Jun"},
+	"antonio":   {"Tonyo", "Tony", "Tonton"},
+	"francisco": {"Isko", "Kiko", "Paquito"},
+	"ramon":     {"Monching", "Mon"},
+	"ricardo":   {"Carding", "Ricky"},
+	"eduardo":   {"Eddie", "Edwin"},
+	"fernando":  {"Nanding", "Ferdie"},
+	"manuel":    {"Maning", "Noel"},
+	"enrique":   {"Iking", "Ike"},
+	"gabriel":   {"Gabby"},
+	"angelo":    {"Gelo"},
+	"emilio":    {"Miling"},
+	"vicente":   {"Enteng", "Vic"},
+	"danilo":    {"Danny"},
+	"ernesto":   {"Erning", "Ernie"},
+	"rafael":    {"Paeng", "Raffy"},
+	"roberto":   {"Berto", "Obet"},
+	"tomas":     {"Tomtom", "Maseng"},
+	"maria":     {"Maring", "Mia"},
+	"teresa":    {"Tessie", "Tessa"},
+	"rosa":      {"Rosing", "Rosie"},
+	"elena":     {"Lenlen", "Ellen"},
+	"patricia":  {"Pat", "Patring"},
+	"cristina":  {"Tina", "Tintin"},
+	"angelica":  {"Gelai", "Angge"},
+	"isabel":    {"Isay", "Belle"},
+	"victoria":  {"Vicky", "Toyang"},
+	"carmen":    {"Carmeling", "Menchu"},
+}
+
+func genSyl(r api.RandLike) string {
+	// Mostly CV(+optional coda), sometimes VCV.
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// 2–3 syllables; low realism allows 1–3
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+	end := api.PickRand(givenEndings, r)
+	if end != "" && !strings.HasSuffix(b.String(), end) {
+		b.WriteString(end)
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	// 2 syllables typically
+	numSyl := 2
+	if realism < 30 && r.Intn(100) < 20 {
+		numSyl = 1
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+	// Surname endings are modest; more likely at higher realism.
+	thr := 20
+	if realism >= 80 {
+		thr = 40
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p filipinoProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -93,59 +223,10 @@ func (p filipinoProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Mostly CV(+optional coda), sometimes VCV.
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		// 2–3 syllables; low realism allows 1–3
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-		end := api.PickRand(givenEndings, r)
-		if end != "" && !strings.HasSuffix(b.String(), end) {
-			b.WriteString(end)
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		// 2 syllables typically
-		numSyl := 2
-		if realism < 30 && r.Intn(100) < 20 {
-			numSyl = 1
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-		// Surname endings are modest; more likely at higher realism.
-		thr := 20
-		if realism >= 80 {
-			thr = 40
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
 	// ---- First name selection ----
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -162,30 +243,30 @@ func (p filipinoProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
 	// ---- Last name selection ----
 	last := ""
 	if cfg.IncludeLast {
 		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		} else {
 			// If Family override is something else, still produce Filipino-ish surname for now.
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
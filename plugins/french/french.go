@@ -1,11 +1,10 @@
 package french
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type frenchProfile struct{}
@@ -23,6 +22,52 @@ func (p frenchProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p frenchProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the French titles and the rare fils and pere suffixes.
+func (p frenchProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "M.", Gender: "male", Weight: 50},
+			{Text: "Mme", Gender: "female", Weight: 45},
+			{Text: "Mlle", Gender: "female", Weight: 5},
+			{Text: "Dr", Weight: 6},
+			{Text: "Me", Weight: 3},
+			{Text: "Pr", Weight: 2},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "fils", Gender: "male", Weight: 3},
+			{Text: "pere", Gender: "male", Weight: 1},
+		},
+		SuffixPct: 2,
+	}
+}
+
+// NicknameRules gives curated short forms and the endings -ou, -ot for men
+// and -ette for women.
+func (p frenchProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:        nicknames,
+		Suffixes:       []string{"ou"},
+		MaleSuffixes:   []string{"ot"},
+		FemaleSuffixes: []string{"ette"},
+	}
+}
+
 // Curated given names (ASCII only; accents removed).
 var firstMale = []string{
 	"Jean", "Pierre", "Louis", "Michel", "Andre", "Paul", "Jacques", "Henri", "Luc", "Thomas",
@@ -62,9 +107,98 @@ var givenEndingsNeutral = []string{"", "", "", "e", "i", "en"}
 
 var surnameEndings = []string{"", "", "", "eau", "et", "ier", "in", "on", "ard", "oux", "ois"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"jean":      {"Jeannot"},
+	"pierre":    {"Pierrot"},
+	"louis":     {"Loulou"},
+	"michel":    {"Mimi"},
+	"jacques":   {"Jacquot", "Jacky"},
+	"nicolas":   {"Nico"},
+	"alexandre": {"Alex"},
+	"guillaume": {"Guigui"},
+	"sebastien": {"Seb", "Sebou"},
+	"maxime":    {"Max"},
+	"francois":  {"Franck", "Fanfan"},
+	"benjamin":  {"Benji", "Ben"},
+	"gabriel":   {"Gabi"},
+	"thomas":    {"Tom", "Toto"},
+	"marie":     {"Manon", "Mimi"},
+	"isabelle":  {"Isa", "Babette"},
+	"nathalie":  {"Nath"},
+	"charlotte": {"Lolotte", "Charlie"},
+	"elisabeth": {"Babette", "Lisette"},
+	"camille":   {"Cam", "Millie"},
+	"juliette":  {"Juju"},
+	"sophie":    {"Fifi"},
+	"helene":    {"Lena"},
+	"valerie":   {"Val"},
+	"aurelie":   {"Lili"},
+	"mathilde":  {"Mathou"},
+}
+
+func genSyl(r api.RandLike) string {
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+	return b.String()
+}
+
 func (p frenchProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -91,70 +225,9 @@ func (p frenchProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -171,28 +244,28 @@ func (p frenchProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
 		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		} else {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
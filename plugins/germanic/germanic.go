@@ -1,11 +1,10 @@
 package germanic
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type germanicProfile struct{}
@@ -23,6 +22,48 @@ func (p germanicProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p germanicProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Herr and Frau, the stacked academic titles, and jun./sen.
+func (p germanicProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Herr", Gender: "male", Weight: 60},
+			{Text: "Frau", Gender: "female", Weight: 60},
+			{Text: "Dr.", Weight: 10},
+			{Text: "Prof. Dr.", Weight: 2},
+			{Text: "Dipl.-Ing.", Weight: 2},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "jun.", Gender: "male", Weight: 3},
+			{Text: "sen.", Gender: "male", Weight: 2},
+		},
+		SuffixPct: 3,
+	}
+}
+
+// NicknameRules gives curated short forms and the endings -i and -chen.
+func (p germanicProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"i", "chen"},
+	}
+}
+
 // Curated given names (ASCII only; expand anytime).
 var firstMale = []string{
 	"Erik", "Karl", "Lars", "Sven", "Bjorn", "Leif", "Nils", "Oskar", "Otto", "Felix",
@@ -65,9 +106,97 @@ var givenEndingsNeutral = []string{"", "", "", "en", "in", "e"}
 
 var surnameEndings = []string{"", "", "", "son", "sen", "berg", "strom", "mann", "wald", "heim", "gaard"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"johann":    {"Hans", "Hannes"},
+	"johannes":  {"Hannes", "Jo"},
+	"friedrich": {"Fritz", "Fred"},
+	"heinrich":  {"Heinz", "Heini"},
+	"wilhelm":   {"Willi", "Wim"},
+	"konrad":    {"Kurt", "Conny"},
+	"dietrich":  {"Dieter", "Dietz"},
+	"karl":      {"Kalle"},
+	"magnus":    {"Mang"},
+	"henrik":    {"Henke"},
+	"felix":     {"Lixi"},
+	"johanna":   {"Hanna", "Jo"},
+	"gertrud":   {"Trude", "Gerda"},
+	"hildegard": {"Hilde"},
+	"anneliese": {"Liese", "Anne"},
+	"katharina": {"Kathi", "Käthe"},
+	"margarete": {"Grete", "Gretchen"},
+	"elisabeth": {"Elsa", "Lisl", "Sissi"},
+	"matilda":   {"Tilda", "Tilly"},
+	"emilia":    {"Emmi"},
+	"sabine":    {"Bine"},
+}
+
+func genSyl(r api.RandLike) string {
+	// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel.
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Ending by gender (light touch)
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Surname endings more likely at higher realism
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
 func (p germanicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -96,75 +225,10 @@ func (p germanicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel.
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Ending by gender (light touch)
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Surname endings more likely at higher realism
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
 
 	// ---- First name selection ----
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -181,30 +245,30 @@ func (p germanicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	// ---- Last name selection ----
 	last := ""
 	if cfg.IncludeLast {
 		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		} else {
 			// If Family override is something else, still produce Germanic-ish surname for now.
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
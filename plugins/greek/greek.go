@@ -2,8 +2,6 @@ package greek
 
 import (
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type greekProfile struct{}
@@ -21,6 +19,43 @@ func (p greekProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p greekProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    firstMale,
+		FirstFemale:  firstFemale,
+		FirstNeutral: firstNeutral,
+		Last:         lastNames,
+		Onsets:       onsets,
+		Nuclei:       vowels,
+		Codas:        codas,
+	}
+}
+
+// Forms gives Kyrios, Kyria and Despinis and the academic titles.
+func (p greekProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Kyrios", Gender: "male", Weight: 50},
+			{Text: "Kyria", Gender: "female", Weight: 50},
+			{Text: "Despinis", Gender: "female", Weight: 8},
+			{Text: "Dr.", Weight: 5},
+			{Text: "Kathigitis", Gender: "male", Weight: 1},
+			{Text: "Kathigitria", Gender: "female", Weight: 1},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms and the endings -akis for men
+// and -oula for women.
+func (p greekProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:        nicknames,
+		MaleSuffixes:   []string{"akis"},
+		FemaleSuffixes: []string{"oula"},
+	}
+}
+
 var firstMale = []string{
 	"Yannis", "Nikos", "Giorgos", "Dimitris", "Kostas", "Panagiotis",
 	"Alexandros", "Stavros", "Christos", "Theodoros",
@@ -55,18 +90,39 @@ var codas = []string{
 	"", "", "", "s", "n", "r",
 }
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"yannis":       {"Yannakis"},
+	"giorgos":      {"Giorgakis"},
+	"dimitris":     {"Mimis", "Takis"},
+	"konstantinos": {"Kostas", "Dinos"},
+	"kostas":       {"Kostakis", "Dinos"},
+	"panagiotis":   {"Panos", "Takis"},
+	"alexandros":   {"Alekos", "Alex"},
+	"christos":     {"Christakis"},
+	"theodoros":    {"Thodoris", "Doros"},
+	"nikos":        {"Nikolakis"},
+	"eleni":        {"Lena", "Elenitsa"},
+	"katerina":     {"Katina", "Rina"},
+	"georgia":      {"Giota"},
+	"ioanna":       {"Yanna"},
+	"christina":    {"Tina"},
+	"eirini":       {"Rena"},
+	"dimitra":      {"Mitsa"},
+	"sofia":        {"Sofoula"},
+}
+
+func gen(r api.RandLike) string {
+	return api.PickRand(onsets, r) +
+		api.PickRand(vowels, r) +
+		api.PickRand(codas, r)
+}
+
 func (p greekProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	useReal := r.Intn(100) < cfg.Realism+15
 
-	gen := func() string {
-		return api.PickRand(onsets, r) +
-			api.PickRand(vowels, r) +
-			api.PickRand(codas, r)
-	}
-
 	first := ""
 	if useReal {
 		switch cfg.Gender {
@@ -78,7 +134,7 @@ func (p greekProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			first = api.PickRand(firstNeutral, r)
 		}
 	} else {
-		first = caser.String(gen() + gen())
+		first = api.Title(gen(r) + gen(r))
 	}
 
 	last := ""
@@ -86,7 +142,7 @@ func (p greekProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 		if useReal {
 			last = api.PickRand(lastNames, r)
 		} else {
-			last = caser.String(gen() + gen() + "s")
+			last = api.Title(gen(r) + gen(r) + "s")
 		}
 	}
 
@@ -4,8 +4,7 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/polynesian"
 )
 
 type hawaiianProfile struct{}
@@ -13,25 +12,65 @@ type hawaiianProfile struct{}
 const PROFILE = "hawaiian"
 
 func init() {
+	culture.Lang.MustValidate(givenMale, givenFemale, givenNeutral, surnames)
 	api.RegisterProfile(PROFILE, Profile)
 }
 
 func (p hawaiianProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Hawaiian-inspired names (ASCII): curated + strict phonotactic procedural fallback; deterministic",
+		"notes": "Hawaiian-inspired names with ʻokina and kahakō (-ascii folds them): curated + validated (C)V procedural fallback; deterministic",
 	}
 }
 
-// Real Hawaiian uses okina and kahako; we keep ASCII-only approximations.
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p hawaiianProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the English titles and Kahu, Kumu and Tutu with the given
+// name.
+func (p hawaiianProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Mr.", Gender: "male", Weight: 30},
+			{Text: "Ms.", Gender: "female", Weight: 30},
+			{Text: "Kahu", Weight: 5, Use: api.UseGiven},
+			{Text: "Kumu", Weight: 10, Use: api.UseGiven},
+			{Text: "Tutu", Weight: 5, Use: api.UseGiven},
+			{Text: "Dr.", Weight: 3},
+		},
+	}
+}
+
+// NicknameRules doubles the open first syllable of longer names (Limuʻīu
+// -> Lili); there are no curated nicknames.
+func (p hawaiianProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		OpenStem:    true,
+		Reduplicate: true,
+	}
+}
+
+// Names are written with the ʻokina and kahakō (macrons); -ascii folds them.
 var givenMale = []string{
 	"Kai", "Keanu", "Koa", "Noa", "Ikaika", "Kekoa", "Makana", "Keoni", "Kaleo", "Kanani",
 	"Maleko", "Kainoa", "Kimo", "Kekai", "Lono", "Keola", "Kekoa", "Makoa", "Nalu", "Kekai",
 }
 
 var givenFemale = []string{
-	"Leilani", "Kalani", "Malia", "Noelani", "Nalani", "Keala", "Moana", "Anela", "Kiana", "Lani",
-	"Makana", "Kailani", "Melia", "Alana", "Kapua", "Mahina", "Kalea", "Kamalani", "Nanea", "Kekepania",
+	"Leilani", "Kalani", "Malia", "Noelani", "Nālani", "Keala", "Moana", "ʻAnela", "Kiana", "Lani",
+	"Makana", "Kailani", "Melia", "ʻAlana", "Kapua", "Mahina", "Kalea", "Kamalani", "Nanea", "Kekepania",
 }
 
 var givenNeutral = []string{
@@ -39,7 +78,7 @@ var givenNeutral = []string{
 }
 
 var surnames = []string{
-	"Kamehameha", "Kalakaua", "Kealoha", "Kawika", "Kailani", "Makana", "Kaleo", "Kamaka", "Keoni", "Kahale",
+	"Kamehameha", "Kalākaua", "Kealoha", "Kawika", "Kailani", "Makana", "Kaleo", "Kamaka", "Keoni", "Kahale",
 }
 
 // Hawaiian phonotactics are very strict: consonants {h,k,l,m,n,p,w} + vowels.
@@ -60,9 +99,49 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "a", "i", "o", "u"}
 var surnameEndings = []string{"", "", "", "lani", "nui", "loa", "mano"}
 
+// culture validates the names and marks procedural ones.
+var culture = polynesian.Culture{Lang: polynesian.Hawaiian}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// Hawaiian names often 2-4 syllables.
+	n := 3
+	if realism < 40 {
+		n = 2 + r.Intn(3)
+	} else if r.Intn(100) < 25 {
+		n = 2 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 35 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 4
+	if realism < 40 {
+		n = 3 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 45 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p hawaiianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	marks := polynesian.MarkRand(cfg)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -89,47 +168,10 @@ func (p hawaiianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		// Hawaiian names often 2-4 syllables.
-		n := 3
-		if realism < 40 {
-			n = 2 + r.Intn(3)
-		} else if r.Intn(100) < 25 {
-			n = 2 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 35 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 4
-		if realism < 40 {
-			n = 3 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 45 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
+	var err error
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -139,19 +181,25 @@ func (p hawaiianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			first = api.PickRand(givenNeutral, r)
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first, err = culture.Procedural(marks, func() string { return genGivenProcedural(r, realism) })
+		if err != nil {
+			return api.NameResult{}, err
+		}
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last, err = culture.Procedural(marks, func() string { return genSurnameProcedural(r, realism) })
+			if err != nil {
+				return api.NameResult{}, err
+			}
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile hawaiianProfile
@@ -1,11 +1,11 @@
 package hebrew
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/semitic"
 )
 
 type hebrewProfile struct{}
@@ -19,11 +19,50 @@ func init() {
 func (p hebrewProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Hebrew names (romanized, ASCII): realism blends curated lists with procedural syllables; deterministic with seed",
+		"notes": "Hebrew names (romanized, ASCII), or in Hebrew script with -script hebrew: curated, theophoric (Netanel, Yehonatan) and procedural names; ben/bat patronymics and Hebraized surnames (Ben-Ami, Bar-On)",
 	}
 }
 
-// Curated given names (romanized; ASCII only).
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p hebrewProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Mar and Gveret and the rabbinical titles.
+func (p hebrewProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Mar", Gender: "male", Weight: 40},
+			{Text: "Gveret", Gender: "female", Weight: 40},
+			{Text: "Dr.", Weight: 6},
+			{Text: "Rav", Gender: "male", Weight: 5},
+			{Text: "Rabbanit", Gender: "female", Weight: 2},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms (Yosef -> Yossi) and the endings
+// -ik and -i.
+func (p hebrewProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"ik", "i"},
+	}
+}
+
+// Curated given names (romanized; ASCII only), spelled in Hebrew letters
+// by lexicon.
 var firstMale = []string{
 	"David", "Daniel", "Yosef", "Moshe", "Avi", "Ariel", "Eitan", "Noam", "Omer", "Itai",
 	"Yonatan", "Natan", "Shlomo", "Yitzhak", "Yaakov", "Gideon", "Uri", "Amir", "Eli", "Shai",
@@ -40,13 +79,102 @@ var firstNeutral = []string{
 	"Noam", "Ariel", "Adi", "Tal", "Lior", "Eden", "Roni", "Nitzan", "Shai", "Maya",
 }
 
-// Curated surnames (common in Israeli / Jewish contexts; ASCII only).
+// Curated surnames (common in Israeli / Jewish contexts; ASCII only):
+// Ashkenazi and Mizrahi family names and Hebraized ones.
 var lastNames = []string{
 	"Cohen", "Levi", "Mizrahi", "Peretz", "Biton", "Dahan", "Katz", "Shapiro", "Friedman", "Rosenberg",
-	"Goldberg", "Weiss", "Klein", "Golan", "Barak", "BenAmi", "BenDavid", "BenHaim", "Azoulay", "Amar",
+	"Goldberg", "Weiss", "Klein", "Golan", "Barak", "Ben-Ami", "Ben-David", "Ben-Haim", "Azoulay", "Amar",
 	"Dayan", "Halevi", "Navon", "Sharabi", "Ohayon", "Sasson", "Segal", "Gross", "Edelstein", "Rabin",
 }
 
+// lexicon spells the curated names in Hebrew letters.
+var lexicon = semitic.Lexicon{
+	"David": "דוד", "Daniel": "דניאל", "Yosef": "יוסף", "Moshe": "משה", "Avi": "אבי", "Ariel": "אריאל",
+	"Eitan": "איתן", "Noam": "נועם", "Omer": "עומר", "Itai": "איתי", "Yonatan": "יונתן", "Natan": "נתן",
+	"Shlomo": "שלמה", "Yitzhak": "יצחק", "Yaakov": "יעקב", "Gideon": "גדעון", "Uri": "אורי", "Amir": "אמיר",
+	"Eli": "אלי", "Shai": "שי", "Lev": "לב", "Asher": "אשר", "Hillel": "הלל", "Nadav": "נדב",
+	"Baruch": "ברוך", "Elazar": "אלעזר", "Ze'ev": "זאב", "Reuven": "ראובן", "Shimon": "שמעון", "Yoav": "יואב",
+
+	"Sarah": "שרה", "Rivka": "רבקה", "Leah": "לאה", "Rachel": "רחל", "Miriam": "מרים", "Hannah": "חנה",
+	"Noa": "נועה", "Yael": "יעל", "Tamar": "תמר", "Avigail": "אביגיל", "Shira": "שירה", "Michal": "מיכל",
+	"Noga": "נוגה", "Eden": "עדן", "Lior": "ליאור", "Adi": "עדי", "Maya": "מאיה", "Tal": "טל",
+	"Orly": "אורלי", "Naama": "נעמה", "Esther": "אסתר", "Hadassah": "הדסה", "Chaya": "חיה", "Tzipora": "ציפורה",
+	"Ofra": "עפרה", "Dana": "דנה", "Gali": "גלי", "Roni": "רוני", "Batya": "בתיה", "Nitzan": "ניצן",
+
+	"Cohen": "כהן", "Levi": "לוי", "Mizrahi": "מזרחי", "Peretz": "פרץ", "Biton": "ביטון", "Dahan": "דהן",
+	"Katz": "כץ", "Shapiro": "שפירא", "Friedman": "פרידמן", "Rosenberg": "רוזנברג", "Goldberg": "גולדברג",
+	"Weiss": "וייס", "Klein": "קליין", "Golan": "גולן", "Barak": "ברק", "Ben-Ami": "בן־עמי",
+	"Ben-David": "בן־דוד", "Ben-Haim": "בן־חיים", "Azoulay": "אזולאי", "Amar": "עמר", "Dayan": "דיין",
+	"Halevi": "הלוי", "Navon": "נבון", "Sharabi": "שרעבי", "Ohayon": "אוחיון", "Sasson": "ששון",
+	"Segal": "סגל", "Gross": "גרוס", "Edelstein": "אדלשטיין", "Rabin": "רבין",
+}
+
+// theophory holds the divine elements of Hebrew names and the roots they
+// join. Women's names take the modern -ela and -ya (Gavriela, Hodaya).
+var theophory = &semitic.Theophory{
+	Prefixes: []semitic.Name{{Roman: "El", Hebrew: "אל"}, {Roman: "Yeho", Hebrew: "יהו"}, {Roman: "Yo", Hebrew: "יו"}},
+	Suffixes: []semitic.Name{{Roman: "el", Hebrew: "אל"}, {Roman: "yahu", Hebrew: "יהו"}},
+	Roots: []semitic.Root{
+		{Pre: semitic.Name{Roman: "natan", Hebrew: "נתן"}, Post: semitic.Name{Roman: "netan", Hebrew: "נתנ"}},
+		{Pre: semitic.Name{Roman: "azar", Hebrew: "עזר"}, Post: semitic.Name{Roman: "azar", Hebrew: "עזר"}},
+		{Pre: semitic.Name{Roman: "hanan", Hebrew: "חנן"}, Post: semitic.Name{Roman: "hanan", Hebrew: "חננ"}},
+		{Pre: semitic.Name{Roman: "yada", Hebrew: "ידע"}, Post: semitic.Name{Roman: "yedia", Hebrew: "ידיע"}},
+		{Pre: semitic.Name{Roman: "shafat", Hebrew: "שפט"}, Post: semitic.Name{Roman: "shefat", Hebrew: "שפט"}},
+		{Pre: semitic.Name{Roman: "yakim", Hebrew: "יקים"}},
+		{Pre: semitic.Name{Roman: "ram", Hebrew: "רם"}},
+		{Pre: semitic.Name{Roman: "nadav", Hebrew: "נדב"}},
+		{Pre: semitic.Name{Roman: "shua", Hebrew: "שוע"}, Only: "Yeho"},
+		{Pre: semitic.Name{Roman: "iezer", Hebrew: "יעזר"}, Only: "El"},
+		{Post: semitic.Name{Roman: "uri", Hebrew: "אורי"}},
+		{Post: semitic.Name{Roman: "gavri", Hebrew: "גברי"}},
+		{Post: semitic.Name{Roman: "dani", Hebrew: "דני"}, Only: "el"},
+		{Post: semitic.Name{Roman: "refa", Hebrew: "רפ"}},
+		{Post: semitic.Name{Roman: "micha", Hebrew: "מיכ"}},
+		{Post: semitic.Name{Roman: "zechar", Hebrew: "זכר"}, Only: "yahu"},
+		{Post: semitic.Name{Roman: "gedal", Hebrew: "גדל"}, Only: "yahu"},
+		{Post: semitic.Name{Roman: "yirme", Hebrew: "ירמ"}, Only: "yahu"},
+		{Post: semitic.Name{Roman: "yesha", Hebrew: "ישע"}, Only: "yahu"},
+		{Post: semitic.Name{Roman: "ovad", Hebrew: "עבד"}, Only: "yahu"},
+		{Post: semitic.Name{Roman: "eli", Hebrew: "אלי"}},
+	},
+	FemaleSuffixes: []semitic.Name{{Roman: "ela", Hebrew: "אלה"}, {Roman: "ya", Hebrew: "יה"}},
+	FemaleRoots: []semitic.Root{
+		{Pre: semitic.Name{Roman: "isheva", Hebrew: "ישבע"}, Only: "El"},
+		{Pre: semitic.Name{Roman: "inoar", Hebrew: "ינוער"}, Only: "El"},
+		{Pre: semitic.Name{Roman: "iana", Hebrew: "יענה"}, Only: "El"},
+		{Pre: semitic.Name{Roman: "cheved", Hebrew: "כבד"}, Only: "Yo"},
+		{Post: semitic.Name{Roman: "netan", Hebrew: "נתנ"}},
+		{Post: semitic.Name{Roman: "gavri", Hebrew: "גברי"}, Only: "ela"},
+		{Post: semitic.Name{Roman: "dani", Hebrew: "דני"}, Only: "ela"},
+		{Post: semitic.Name{Roman: "uri", Hebrew: "אורי"}, Only: "ela"},
+		{Post: semitic.Name{Roman: "refa", Hebrew: "רפ"}, Only: "ela"},
+		{Post: semitic.Name{Roman: "hoda", Hebrew: "הוד"}, Only: "ya"},
+		{Post: semitic.Name{Roman: "ode", Hebrew: "אוד"}, Only: "ya"},
+		{Post: semitic.Name{Roman: "ahuv", Hebrew: "אהוב"}, Only: "ya"},
+	},
+}
+
+// Modern Israeli surnames, Hebraized from diaspora ones since the early
+// 20th century: Ben- or Bar- and a name or word (Ben-Gurion, Bar-On), or a
+// Hebrew word of its own (Golan, Barak).
+var (
+	hebraizedBen = []semitic.Name{
+		{Roman: "Ami", Hebrew: "עמי"}, {Roman: "David", Hebrew: "דוד"}, {Roman: "Haim", Hebrew: "חיים"}, {Roman: "Zvi", Hebrew: "צבי"}, {Roman: "Gurion", Hebrew: "גוריון"},
+		{Roman: "Yehuda", Hebrew: "יהודה"}, {Roman: "Shimon", Hebrew: "שמעון"}, {Roman: "Ari", Hebrew: "ארי"}, {Roman: "Dor", Hebrew: "דור"}, {Roman: "Natan", Hebrew: "נתן"},
+		{Roman: "Moshe", Hebrew: "משה"}, {Roman: "Tov", Hebrew: "טוב"}, {Roman: "Zion", Hebrew: "ציון"}, {Roman: "Porat", Hebrew: "פורת"},
+	}
+	hebraizedBar = []semitic.Name{
+		{Roman: "On", Hebrew: "און"}, {Roman: "Lev", Hebrew: "לב"}, {Roman: "Ilan", Hebrew: "אילן"}, {Roman: "Am", Hebrew: "עם"}, {Roman: "Zohar", Hebrew: "זוהר"},
+		{Roman: "Oz", Hebrew: "עוז"}, {Roman: "Tal", Hebrew: "טל"}, {Roman: "Nir", Hebrew: "ניר"}, {Roman: "Yosef", Hebrew: "יוסף"}, {Roman: "Natan", Hebrew: "נתן"},
+	}
+	hebraizedWords = []semitic.Name{
+		{Roman: "Golan", Hebrew: "גולן"}, {Roman: "Barak", Hebrew: "ברק"}, {Roman: "Sharon", Hebrew: "שרון"}, {Roman: "Carmel", Hebrew: "כרמל"}, {Roman: "Oren", Hebrew: "אורן"},
+		{Roman: "Alon", Hebrew: "אלון"}, {Roman: "Tamir", Hebrew: "תמיר"}, {Roman: "Sela", Hebrew: "סלע"}, {Roman: "Peled", Hebrew: "פלד"}, {Roman: "Shamir", Hebrew: "שמיר"},
+		{Roman: "Navon", Hebrew: "נבון"}, {Roman: "Amit", Hebrew: "עמית"}, {Roman: "Eshkol", Hebrew: "אשכול"}, {Roman: "Ziv", Hebrew: "זיו"}, {Roman: "Lapid", Hebrew: "לפיד"},
+		{Roman: "Dagan", Hebrew: "דגן"}, {Roman: "Arad", Hebrew: "ערד"}, {Roman: "Tzur", Hebrew: "צור"},
+	}
+)
+
 // Procedural building blocks (Hebrew-ish romanization, simplified).
 var vowels = []string{"a", "e", "i", "o", "u", "ai", "ei", "ia", "oa"}
 var onsets = []string{
@@ -62,130 +190,217 @@ var givenEndingsNeutral = []string{"", "", "", "a", "el", "on"}
 
 var surnameEndings = []string{"", "", "", "man", "berg", "stein", "son", "i"}
 
-func (p hebrewProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
-	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
-
-	realism := cfg.Realism
-	if realism < 0 {
-		realism = 0
-	}
-	if realism > 100 {
-		realism = 100
-	}
-
-	// same curve as other profiles
-	useRealPct := 0
-	switch {
-	case realism >= 95:
-		useRealPct = 95
-	case realism >= 90:
-		useRealPct = 90
-	case realism >= 80:
-		useRealPct = 80
-	case realism >= 70:
-		useRealPct = 55
-	case realism >= 60:
-		useRealPct = 35
-	case realism >= 40:
-		useRealPct = 20
-	default:
-		useRealPct = 5
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"avraham":  {"Avi"},
+	"yosef":    {"Yossi", "Yossele"},
+	"moshe":    {"Moishe", "Moshiko"},
+	"yonatan":  {"Yoni"},
+	"yitzhak":  {"Itzik", "Tzachi"},
+	"yaakov":   {"Kobi", "Yanki"},
+	"shlomo":   {"Shlomi", "Momo"},
+	"binyamin": {"Benny", "Bibi"},
+	"david":    {"Dudi", "Dudu"},
+	"daniel":   {"Dani"},
+	"eliyahu":  {"Eli"},
+	"eitan":    {"Eti"},
+	"gideon":   {"Gidi"},
+	"natan":    {"Nati"},
+	"baruch":   {"Bubi"},
+	"reuven":   {"Rubi"},
+	"shimon":   {"Shimi"},
+	"sarah":    {"Sari", "Sarale"},
+	"rivka":    {"Riki", "Rivi"},
+	"miriam":   {"Miri"},
+	"avigail":  {"Avi", "Gali"},
+	"michal":   {"Michali"},
+	"tzipora":  {"Tzipi"},
+	"hannah":   {"Hani"},
+	"esther":   {"Esti"},
+	"batya":    {"Bati"},
+	"hadassah": {"Dassi"},
+	"chaya":    {"Chayale"},
+	"rachel":   {"Rochi"},
+}
+
+// conventionHebraized asks for a modern Israeli Hebraized surname
+// (ProfileConfig.Convention).
+const conventionHebraized = "hebraized"
+
+// Chances, in percent: that a curated given name is a theophoric compound
+// instead, that a procedural one takes a theophoric affix, and that a
+// curated surname is a generated Hebraized one.
+const (
+	theophoricPct = 20
+	affixPct      = 30
+	hebraizedPct  = 25
+)
+
+func genSyl(r api.RandLike) string {
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
 
-	genSyl := func() string {
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+// genRoot strings procedural syllables together.
+func genRoot(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
 	}
+	return b.String()
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	var b strings.Builder
+	b.WriteString(genRoot(r, realism))
 
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-		return b.String()
 	}
+	return b.String()
+}
 
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2)
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2)
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
 
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
 		}
-		return b.String()
 	}
+	return b.String()
+}
 
-	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(firstMale, r)
-		case "female":
-			first = api.PickRand(firstFemale, r)
-		default:
-			roll := r.Intn(100)
-			if roll < 60 {
-				first = api.PickRand(firstNeutral, r)
-			} else if roll < 80 {
-				first = api.PickRand(firstMale, r)
-			} else {
-				first = api.PickRand(firstFemale, r)
-			}
+// procedural spells a procedural word as a name.
+func procedural(word string) semitic.Name {
+	name := api.Title(word)
+	return semitic.Name{Roman: name, Hebrew: semitic.Spell(name)}
+}
+
+// pickGiven picks a given name for gender: curated, a theophoric compound,
+// or procedural.
+func pickGiven(r api.RandLike, cfg api.ProfileConfig, gender string, realism, useRealPct int) semitic.Name {
+	if !api.Chance(r, useRealPct) {
+		if api.Chance(r, affixPct) {
+			return theophory.Affix(r, gender, procedural(genRoot(r, realism)))
 		}
-	} else {
-		first = caser.String(genGivenProcedural())
+		cfg.Gender = gender
+		return procedural(genGivenProcedural(r, cfg, realism))
+	}
+	if api.Chance(r, theophoricPct) {
+		return theophory.Compose(r, gender)
 	}
+	switch gender {
+	case "male":
+		return lexicon.Name(api.PickRand(firstMale, r))
+	case "female":
+		return lexicon.Name(api.PickRand(firstFemale, r))
+	}
+	roll := r.Intn(100)
+	if roll < 60 {
+		return lexicon.Name(api.PickRand(firstNeutral, r))
+	} else if roll < 80 {
+		return lexicon.Name(api.PickRand(firstMale, r))
+	}
+	return lexicon.Name(api.PickRand(firstFemale, r))
+}
 
-	last := ""
+// hebraized builds a modern Israeli surname: Ben-Ami, Bar-On, Golan.
+func hebraized(r api.RandLike) semitic.Name {
+	var link, word semitic.Name
+	switch roll := r.Intn(100); {
+	case roll < 35:
+		link, word = semitic.Name{Roman: "Ben", Hebrew: "בן"}, api.PickRand(hebraizedBen, r)
+	case roll < 60:
+		link, word = semitic.Name{Roman: "Bar", Hebrew: "בר"}, api.PickRand(hebraizedBar, r)
+	default:
+		return api.PickRand(hebraizedWords, r)
+	}
+	return semitic.Name{
+		Roman:  link.Roman + "-" + word.Roman,
+		Hebrew: semitic.Finalize(link.Hebrew + semitic.Maqaf + word.Hebrew),
+	}
+}
+
+// pickSurname picks a family name: curated or Hebraized, or procedural.
+func pickSurname(r api.RandLike, realism, useRealPct int) semitic.Name {
+	if !api.Chance(r, useRealPct) {
+		return procedural(genSurnameProcedural(r, realism))
+	}
+	if api.Chance(r, hebraizedPct) {
+		return hebraized(r)
+	}
+	return lexicon.Name(api.PickRand(lastNames, r))
+}
+
+func (p hebrewProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
+
+	first := pickGiven(r, cfg, cfg.Gender, realism, useRealPct)
+	res := api.NameResult{First: first.Roman}
+	var last semitic.Name
 	if cfg.IncludeLast {
-		if chooseFromReal() {
-			last = api.PickRand(lastNames, r)
-		} else {
-			last = caser.String(genSurnameProcedural())
+		switch strings.ToLower(strings.TrimSpace(cfg.Convention)) {
+		case api.ConventionPatronymic:
+			father := pickGiven(r, cfg, "male", realism, useRealPct)
+			last = semitic.Hebrew.Patronymic(father, cfg.Gender)
+			res.Parts = &api.NameParts{Convention: api.ConventionPatronymic, Father: father.Roman}
+		case conventionHebraized:
+			last = hebraized(r)
+			res.Parts = &api.NameParts{Convention: conventionHebraized}
+		default:
+			last = pickSurname(r, realism, useRealPct)
 		}
+		res.Last = last.Roman
 	}
+	if script := api.NativeScript(p, cfg); script != "" {
+		res.Native = semitic.Render(strings.TrimSpace(first.Hebrew+" "+last.Hebrew), script)
+	}
+	return res, nil
+}
+
+// Scripts lists the native script: Hebrew.
+func (p hebrewProfile) Scripts() []string {
+	return []string{semitic.ScriptHebrew}
+}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+// FormPatronymic lets family trees use the patronymic convention: ben
+// David, bat David.
+func (p hebrewProfile) FormPatronymic(parent string, _ bool, gender, _ string) string {
+	return semitic.Hebrew.Patronymic(lexicon.Name(parent), gender).Roman
 }
 
 // Profile is the core exported symbol
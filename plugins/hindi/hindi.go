@@ -4,8 +4,7 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/indic"
 )
 
 type hindiProfile struct{}
@@ -19,10 +18,50 @@ func init() {
 func (p hindiProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Hindi / North Indian names (romanized, ASCII)",
+		"notes": "Hindi / North Indian names (romanized, ASCII); conventions surname, initials, patronymic, caste-neutral",
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p hindiProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    firstMale,
+		FirstFemale:  firstFemale,
+		FirstNeutral: firstNeutral,
+		Last:         lastNames,
+		Onsets:       onsets,
+		Nuclei:       vowels,
+		Codas:        codas,
+	}
+}
+
+// Forms gives Shri, Shrimati and Kumari, and the respectful -ji.
+func (p hindiProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Shri", Gender: "male", Weight: 40},
+			{Text: "Shrimati", Gender: "female", Weight: 30},
+			{Text: "Kumari", Gender: "female", Weight: 10},
+			{Text: "Dr.", Weight: 8},
+			{Text: "ji", Weight: 15, After: true, Join: "-"},
+		},
+	}
+}
+
+// NicknameRules gives curated pet names and the endings -u and the
+// respectful -ji.
+func (p hindiProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"u", "-ji"},
+	}
+}
+
+// FormPatronymic lets family trees use the patronymic convention.
+func (p hindiProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	return region.FormPatronymic(parent, matronymic, gender, locale)
+}
+
 var firstMale = []string{
 	"Rahul", "Amit", "Vikram", "Arjun", "Rohit", "Suresh", "Anil", "Rajesh",
 	"Manish", "Sanjay", "Deepak", "Kunal", "Nitin", "Ashok", "Pradeep",
@@ -45,6 +84,27 @@ var lastNames = []string{
 	"Mehta", "Bansal", "Joshi", "Pandey", "Tiwari", "Goyal", "Jain",
 }
 
+// Caste-neutral surnames, chosen by families dropping a caste name.
+var neutralSurnames = []string{
+	"Kumar", "Bharati", "Azad", "Raj", "Anand", "Prakash", "Chandra", "Dev",
+}
+
+// region carries the conventions; Singh and Kumar are men's surnames, Kaur
+// and Kumari the women's.
+var region = indic.Region{
+	Male: firstMale, Female: firstFemale, Neutral: firstNeutral,
+	Surnames:        lastNames,
+	NeutralSurnames: neutralSurnames,
+	FemaleSurnames:  map[string]string{"Singh": "Kaur", "Kumar": "Kumari"},
+	InitialFather:   true,
+	GenGiven: func(r api.RandLike, _ string, realism int) string {
+		return genGiven(r, realism)
+	},
+	GenSurname: func(r api.RandLike, _ int) string {
+		return genSyl(r) + genSyl(r)
+	},
+}
+
 var onsets = []string{
 	"b", "bh", "d", "dh", "g", "gh", "k", "kh", "m", "n", "p", "ph",
 	"r", "s", "sh", "t", "th", "v", "y", "ch", "j",
@@ -59,9 +119,46 @@ var codas = []string{
 	"", "", "", "n", "m", "r", "sh", "t", "k",
 }
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"rajesh":    {"Raju"},
+	"ramesh":    {"Ramu"},
+	"suresh":    {"Suri"},
+	"mahesh":    {"Mahi"},
+	"vikram":    {"Vicky"},
+	"abhishek":  {"Abhi"},
+	"siddharth": {"Sid", "Siddhu"},
+	"aditya":    {"Adi"},
+	"rahul":     {"Rahu"},
+	"priya":     {"Pri", "Priyu"},
+	"anjali":    {"Anju"},
+	"pooja":     {"Poo", "Pooju"},
+	"neha":      {"Nehu"},
+	"kavita":    {"Kavi"},
+	"sunita":    {"Suni"},
+	"deepika":   {"Deepu"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) +
+		api.PickRand(vowels, r) +
+		api.PickRand(codas, r)
+}
+
+func genGiven(r api.RandLike, realism int) string {
+	n := 2
+	if realism < 40 && r.Intn(100) < 30 {
+		n = 3
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	return b.String()
+}
+
 func (p hindiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -75,28 +172,9 @@ func (p hindiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	if realPct > 95 {
 		realPct = 95
 	}
-	useReal := func() bool { return r.Intn(100) < realPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) +
-			api.PickRand(vowels, r) +
-			api.PickRand(codas, r)
-	}
-
-	genGiven := func() string {
-		n := 2
-		if realism < 40 && r.Intn(100) < 30 {
-			n = 3
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		return b.String()
-	}
 
 	first := ""
-	if useReal() {
+	if api.Chance(r, realPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -106,22 +184,14 @@ func (p hindiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			first = api.PickRand(firstNeutral, r)
 		}
 	} else {
-		first = caser.String(genGiven())
+		first = api.Title(genGiven(r, realism))
 	}
 
-	last := ""
-	if cfg.IncludeLast {
-		if useReal() {
-			last = api.PickRand(lastNames, r)
-		} else {
-			last = caser.String(genSyl() + genSyl())
-		}
+	first = api.Title(first)
+	if !cfg.IncludeLast {
+		return api.NameResult{First: first}, nil
 	}
-
-	return api.NameResult{
-		First: caser.String(first),
-		Last:  caser.String(last),
-	}, nil
+	return region.Compose(r, cfg, first, realism, realPct), nil
 }
 
 var Profile hindiProfile
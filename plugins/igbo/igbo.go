@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type igboProfile struct{}
@@ -23,6 +21,46 @@ func (p igboProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p igboProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Mazi, Nze and Ichie for men, Lolo and Ezinne for women, and
+// Chief before the full name.
+func (p igboProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Chief", Weight: 25, Use: api.UseFull},
+			{Text: "Mazi", Gender: "male", Weight: 30},
+			{Text: "Nze", Gender: "male", Weight: 8},
+			{Text: "Ichie", Gender: "male", Weight: 4},
+			{Text: "Lolo", Gender: "female", Weight: 15},
+			{Text: "Ezinne", Gender: "female", Weight: 10},
+			{Text: "Dr.", Weight: 4},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms (Chukwuemeka -> Emeka) and
+// open-syllable stems of other names.
+func (p igboProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		OpenStem: true,
+	}
+}
+
 // Igbo names are often meaningful phrases; many are gender-neutral.
 var givenMale = []string{
 	"Chinedu", "Emeka", "Ifeanyi", "Nnamdi", "Obinna", "Chukwudi", "Uche", "Ikenna", "Onyekachi", "Ifeoma",
@@ -62,9 +100,61 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "chi", "ma", "na", "du", "ka"}
 var surnameEndings = []string{"", "", "", "eze", "chukwu", "nna", "for"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"chukwuemeka": {"Emeka"},
+	"chinedu":     {"Nedu"},
+	"chidinma":    {"Dinma"},
+	"ngozi":       {"Ngo"},
+	"nkechi":      {"Kechi"},
+	"obinna":      {"Obi"},
+	"chimamanda":  {"Ada", "Manda"},
+	"oluchi":      {"Luchi"},
+	"uchenna":     {"Uche"},
+	"ifeanyi":     {"Ify"},
+	"ifeoma":      {"Ify"},
+	"chukwudi":    {"Chudi"},
+	"chiamaka":    {"Amaka"},
+	"chioma":      {"Oma"},
+	"nnamdi":      {"Nnam"},
+	"kelechi":     {"Kc", "Kele"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) +
+		api.PickRand(vowels, r) +
+		api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	n := 3
+	if realism < 40 {
+		n = 2 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 50 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike) string {
+	n := 2 + r.Intn(2)
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 55 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p igboProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -91,43 +181,9 @@ func (p igboProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) +
-			api.PickRand(vowels, r) +
-			api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 3
-		if realism < 40 {
-			n = 2 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 50 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2)
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 55 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -137,19 +193,19 @@ func (p igboProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			first = api.PickRand(givenNeutral, r)
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = api.Title(genSurnameProcedural(r))
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile igboProfile
@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type indonesianProfile struct{}
@@ -23,6 +21,44 @@ func (p indonesianProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p indonesianProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Bapak/Pak and Ibu/Bu, used with the given name.
+func (p indonesianProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Bapak", Gender: "male", Weight: 40, Use: api.UseGiven},
+			{Text: "Ibu", Gender: "female", Weight: 40, Use: api.UseGiven},
+			{Text: "Pak", Gender: "male", Weight: 20, Use: api.UseGiven},
+			{Text: "Bu", Gender: "female", Weight: 20, Use: api.UseGiven},
+			{Text: "Saudara", Gender: "male", Weight: 5},
+			{Text: "Saudari", Gender: "female", Weight: 5},
+			{Text: "Dr.", Weight: 4},
+		},
+	}
+}
+
+// NicknameRules gives the curated panggilan (call names).
+func (p indonesianProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated: nicknames,
+	}
+}
+
 // Indonesia has many naming conventions; many people have a single name.
 // We'll generate a given name (First) and optionally a surname-ish (Last).
 var givenMale = []string{
@@ -63,9 +99,55 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "an", "ah", "i", "u"}
 var surnameEndings = []string{"", "", "", "wan", "man", "yah", "tama", "putra", "sari"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"muhammad": {"Mamat"},
+	"budi":     {"Bud"},
+	"agus":     {"Gus"},
+	"dewi":     {"Wi"},
+	"putri":    {"Put"},
+	"wahyu":    {"Yayu"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// 2 syllables common; allow 1-3.
+	n := 2
+	if realism < 40 {
+		n = 1 + r.Intn(3) // 1..3
+	} else if r.Intn(100) < 20 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 30 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 45 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p indonesianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -92,47 +174,9 @@ func (p indonesianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, erro
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		// 2 syllables common; allow 1-3.
-		n := 2
-		if realism < 40 {
-			n = 1 + r.Intn(3) // 1..3
-		} else if r.Intn(100) < 20 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 30 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3) // 1..3
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 45 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -149,22 +193,22 @@ func (p indonesianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, erro
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = api.Title(genSurnameProcedural(r, realism))
 		}
 	} else {
 		// Many Indonesians have a single name; at mid realism, often omit last implicitly.
 		// (No-op)
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile indonesianProfile
@@ -0,0 +1,196 @@
+// Package indic is the naming subsystem shared by the Indian profiles
+// (hindi, tamil, bengali, telugu, marathi, punjabi, malayalam). A Region
+// holds one tradition's lists and habits; the profiles keep their own
+// Info, Forms and nicknames and delegate names to it.
+//
+// Every region supports the same conventions (ProfileConfig.Convention):
+//
+//   - surname: given name and family name, in the region's order
+//   - initials: the father's (or house name's) initial first, "R. Karthik"
+//   - patronymic: the father's given name as the surname, "Karthik Ramasamy"
+//   - caste-neutral: a surname that marks no caste or community, or the
+//     father's name where the region has none
+package indic
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Region describes one regional naming tradition.
+type Region struct {
+	Male, Female, Neutral []string
+	Surnames              []string
+
+	// NeutralSurnames are the caste-neutral surnames; nil makes the
+	// caste-neutral convention use the father's given name instead.
+	NeutralSurnames []string
+
+	// FemaleSurnames gives gender-determined surnames their women's form:
+	// Singh -> Kaur, Kumar -> Kumari.
+	FemaleSurnames map[string]string
+
+	// Required, when set, comes before any family name: Punjabi Singh/Kaur
+	// (given with its FemaleSurnames form). ClanPct is the chance, in
+	// percent, of a family name after it.
+	Required string
+	ClanPct  int
+
+	Convention   string // default convention; "" is surname
+	FamilyFirst  bool   // house name before the given name (Telugu)
+	FatherMiddle bool   // father's given name between given and family name (Marathi)
+
+	// Which names the initials convention abbreviates, in order: the house
+	// name (Telugu, Malayalam) and the father's name (Tamil, Malayalam).
+	InitialFamily, InitialFather bool
+
+	// The region's procedural sounds: syllable onsets and the endings of
+	// given names and surnames. Nil lists use the shared Indic ones.
+	Onsets                                     []string
+	MaleEndings, FemaleEndings, NeutralEndings []string
+	SurnameEndings                             []string
+
+	// Procedural generators; nil builds names from the sounds above.
+	GenGiven   func(r api.RandLike, gender string, realism int) string
+	GenSurname func(r api.RandLike, realism int) string
+}
+
+// Generate makes a full name, for regions without a profile-specific
+// given-name picker.
+func (rg *Region) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
+
+	first := rg.Given(r, cfg.Gender, realism, useRealPct)
+	if !cfg.IncludeLast {
+		return api.NameResult{First: first}, nil
+	}
+	return rg.Compose(r, cfg, first, realism, useRealPct), nil
+}
+
+// Given picks a given name: curated with probability useRealPct,
+// otherwise procedural.
+func (rg *Region) Given(r api.RandLike, gender string, realism, useRealPct int) string {
+	if !api.Chance(r, useRealPct) {
+		if rg.GenGiven != nil {
+			return api.Title(rg.GenGiven(r, gender, realism))
+		}
+		return api.Title(rg.genGiven(r, gender, realism))
+	}
+	switch gender {
+	case "male":
+		return api.PickRand(rg.Male, r)
+	case "female":
+		return api.PickRand(rg.Female, r)
+	}
+	return api.PickRand(rg.Neutral, r)
+}
+
+// Family picks a family name in its form for gender.
+func (rg *Region) Family(r api.RandLike, gender string, realism, useRealPct int) string {
+	if !api.Chance(r, useRealPct) {
+		if rg.GenSurname != nil {
+			return api.Title(rg.GenSurname(r, realism))
+		}
+		return api.Title(rg.genSurname(r))
+	}
+	return rg.Inflect(api.PickRand(rg.Surnames, r), gender)
+}
+
+// Inflect returns surname in its form for gender: Kaur for a woman's Singh.
+func (rg *Region) Inflect(surname, gender string) string {
+	if gender == "female" {
+		if f, ok := rg.FemaleSurnames[surname]; ok {
+			return f
+		}
+	}
+	return surname
+}
+
+// ResolveConvention returns the convention a name follows: one of the four
+// above, or the region's default.
+func (rg *Region) ResolveConvention(convention string) string {
+	switch c := strings.ToLower(strings.TrimSpace(convention)); c {
+	case api.ConventionSurname, api.ConventionInitials, api.ConventionPatronymic, api.ConventionNeutral:
+		return c
+	}
+	if rg.Convention != "" {
+		return rg.Convention
+	}
+	return api.ConventionSurname
+}
+
+// Compose completes a name whose given name is chosen, following
+// cfg.Convention. Names whose display is not First + Last (initials,
+// house name first, the father as a middle name) carry it in Parts.Full.
+func (rg *Region) Compose(r api.RandLike, cfg api.ProfileConfig, first string, realism, useRealPct int) api.NameResult {
+	convention := rg.ResolveConvention(cfg.Convention)
+	father := func() string { return rg.Given(r, "male", realism, useRealPct) }
+
+	switch convention {
+	case api.ConventionPatronymic:
+		f := father()
+		return api.NameResult{First: first, Last: f, Parts: &api.NameParts{Convention: convention, Father: f}}
+
+	case api.ConventionNeutral:
+		if rg.NeutralSurnames == nil {
+			f := father()
+			return api.NameResult{First: first, Last: f, Parts: &api.NameParts{Convention: convention, Father: f}}
+		}
+		last := rg.Inflect(api.PickRand(rg.NeutralSurnames, r), cfg.Gender)
+		return api.NameResult{First: first, Last: last, Parts: &api.NameParts{Convention: convention}}
+
+	case api.ConventionInitials:
+		parts := &api.NameParts{Convention: convention}
+		var initials []string
+		if rg.InitialFamily || !rg.InitialFather {
+			parts.SurnameBase = rg.Family(r, "male", realism, useRealPct)
+			initials = append(initials, initial(parts.SurnameBase))
+		}
+		if rg.InitialFather {
+			parts.Father = father()
+			initials = append(initials, initial(parts.Father))
+		}
+		last := strings.Join(initials, " ")
+		parts.Full = last + " " + first
+		return api.NameResult{First: first, Last: last, Parts: parts}
+	}
+
+	// surname
+	var f string
+	if rg.FatherMiddle {
+		f = father()
+	}
+	last := rg.Family(r, cfg.Gender, realism, useRealPct)
+	if rg.Required != "" {
+		family := last
+		last = rg.Inflect(rg.Required, cfg.Gender)
+		if api.Chance(r, rg.ClanPct) {
+			last += " " + family
+		}
+	}
+	switch {
+	case rg.FatherMiddle:
+		return api.NameResult{First: first, Last: last,
+			Parts: &api.NameParts{Convention: convention, Father: f, Full: first + " " + f + " " + last}}
+	case rg.FamilyFirst:
+		return api.NameResult{First: first, Last: last, Parts: &api.NameParts{Convention: convention, Full: last + " " + first}}
+	}
+	return api.NameResult{First: first, Last: last}
+}
+
+// FormPatronymic is the patronymic convention for genealogy: the father's
+// given name is the child's surname unchanged, for sons and daughters.
+func (rg *Region) FormPatronymic(parent string, _ bool, _, _ string) string {
+	return parent
+}
+
+// initial abbreviates a name: "Ramasamy" -> "R.".
+func initial(name string) string {
+	for _, c := range name {
+		return strings.ToUpper(string(c)) + "."
+	}
+	return ""
+}
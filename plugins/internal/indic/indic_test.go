@@ -0,0 +1,66 @@
+package indic_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/bengali"
+	"github.com/nsa-yoda/namegen/plugins/malayalam"
+	"github.com/nsa-yoda/namegen/plugins/marathi"
+	"github.com/nsa-yoda/namegen/plugins/punjabi"
+	"github.com/nsa-yoda/namegen/plugins/telugu"
+)
+
+// TestRegionsDiffer checks that regions built on the shared phonotactics
+// give different procedural names for the same seed, and that no vowel is
+// written three times where a stem meets an ending ("Jaayeeesh").
+func TestRegionsDiffer(t *testing.T) {
+	profiles := map[string]api.NameProfile{
+		"bengali":   bengali.Profile,
+		"telugu":    telugu.Profile,
+		"marathi":   marathi.Profile,
+		"punjabi":   punjabi.Profile,
+		"malayalam": malayalam.Profile,
+	}
+	// Realism 0 still draws a few curated names, which regions share.
+	curated := map[string]bool{}
+	for _, p := range profiles {
+		inv := p.(api.Inventoried).Inventory()
+		for _, n := range slices.Concat(inv.FirstMale, inv.FirstFemale, inv.FirstNeutral) {
+			curated[n] = true
+		}
+	}
+	for _, gender := range []string{"male", "female", "neutral"} {
+		for seed := int64(1); seed <= 100; seed++ {
+			cfg := api.ProfileConfig{Seed: seed, Realism: 0, Gender: gender, IncludeLast: true}
+			seen := map[string]string{}
+			for mode, p := range profiles {
+				res, err := p.Generate(cfg)
+				if err != nil {
+					t.Fatalf("%s: %v", mode, err)
+				}
+				if other, ok := seen[res.First]; ok && !curated[res.First] {
+					t.Errorf("seed %d %s: %s and %s both gave %q", seed, gender, mode, other, res.First)
+				}
+				seen[res.First] = mode
+				for _, name := range []string{res.First, res.Last} {
+					if triple(strings.ToLower(name)) {
+						t.Errorf("seed %d %s: %s name %q has a vowel three times", seed, gender, mode, name)
+					}
+				}
+			}
+		}
+	}
+}
+
+// triple reports whether s has the same vowel three times in a row.
+func triple(s string) bool {
+	for _, v := range []string{"aaa", "eee", "iii", "ooo", "uuu"} {
+		if strings.Contains(s, v) {
+			return true
+		}
+	}
+	return false
+}
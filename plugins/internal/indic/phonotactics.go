@@ -0,0 +1,116 @@
+package indic
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Shared Indic phonotactics (romanized, ASCII): aspirated stops, long
+// vowels written doubled, open syllables with light codas. Regions replace
+// the onsets and endings with their own.
+var (
+	onsets = []string{
+		"", "",
+		"b", "bh", "d", "dh", "g", "gh", "k", "kh", "m", "n", "p", "ph",
+		"r", "s", "sh", "t", "th", "v", "y", "ch", "j", "h", "l",
+		"pr", "kr", "shr",
+	}
+	nuclei = []string{"a", "a", "aa", "i", "ee", "u", "oo", "e", "o", "ai"}
+	codas  = []string{"", "", "", "", "n", "m", "r", "sh", "t", "l"}
+
+	endingsMale    = []string{"", "", "", "esh", "an", "endra", "it", "ant", "raj"}
+	endingsFemale  = []string{"", "", "", "a", "i", "ita", "ini", "ika", "shree"}
+	endingsNeutral = []string{"", "", "", "an", "i", "a"}
+	surnameEndings = []string{"", "", "kar", "wala", "an", "ia", "e"}
+)
+
+// or returns list, or def when list is nil.
+func or(list, def []string) []string {
+	if list == nil {
+		return def
+	}
+	return list
+}
+
+func (rg *Region) genSyl(r api.RandLike) string {
+	return api.PickRand(or(rg.Onsets, onsets), r) + api.PickRand(nuclei, r) + api.PickRand(codas, r)
+}
+
+// genSyls joins n syllables; one without an onset does not follow an open
+// syllable ("yaa" + "abh" would run three vowels together).
+func (rg *Region) genSyls(r api.RandLike, n int) string {
+	var b strings.Builder
+	for range n {
+		syl := rg.genSyl(r)
+		for b.Len() > 0 && isVowel(b.String()[b.Len()-1]) && isVowel(syl[0]) {
+			syl = rg.genSyl(r)
+		}
+		b.WriteString(syl)
+	}
+	return b.String()
+}
+
+func (rg *Region) genGiven(r api.RandLike, gender string, realism int) string {
+	n := 2
+	if realism < 40 && r.Intn(100) < 30 {
+		n = 3
+	}
+	stem := rg.genSyls(r, n)
+	endings := or(rg.NeutralEndings, endingsNeutral)
+	switch gender {
+	case "male":
+		endings = or(rg.MaleEndings, endingsMale)
+	case "female":
+		endings = or(rg.FemaleEndings, endingsFemale)
+	}
+	return attach(stem, api.PickRand(endings, r))
+}
+
+func (rg *Region) genSurname(r api.RandLike) string {
+	s := rg.genSyls(r, 2)
+	if r.Intn(100) < 40 {
+		s = attach(s, api.PickRand(or(rg.SurnameEndings, surnameEndings), r))
+	}
+	return s
+}
+
+// attach adds end to stem. An ending starting with a vowel replaces the
+// vowels the stem ends in ("jaayee" + "esh" -> "jaayesh"); it is dropped
+// when the stem already ends in it or would lose its last syllable.
+func attach(stem, end string) string {
+	if end == "" || strings.HasSuffix(stem, end) {
+		return stem
+	}
+	if isVowel(end[0]) {
+		trimmed := strings.TrimRight(stem, "aeiou")
+		if trimmed != stem && strings.IndexAny(trimmed, "aeiou") < 0 {
+			return stem
+		}
+		stem = trimmed
+	}
+	return stem + end
+}
+
+func isVowel(c byte) bool { return strings.IndexByte("aeiou", c) >= 0 }
+
+// Inventory exposes the region's lists and syllable parts for
+// api.EstimateSpace.
+func (rg *Region) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    rg.Male,
+		FirstFemale:  rg.Female,
+		FirstNeutral: rg.Neutral,
+		Last:         rg.Surnames,
+		Onsets:       or(rg.Onsets, onsets),
+		Nuclei:       nuclei,
+		Codas:        codas,
+		GivenEndings: slices.Concat(
+			or(rg.MaleEndings, endingsMale),
+			or(rg.FemaleEndings, endingsFemale),
+			or(rg.NeutralEndings, endingsNeutral),
+		),
+		SurnameEndings: or(rg.SurnameEndings, surnameEndings),
+	}
+}
@@ -0,0 +1,120 @@
+package polynesian
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Culture is a Polynesian profile's name lists on its Language; profiles
+// without their own generator (tongan, tahitian) delegate to it.
+type Culture struct {
+	Lang                  Language
+	Male, Female, Neutral []string
+	Surnames              []string
+	GivenEndings          []string
+	SurnameEndings        []string
+}
+
+// Nuclei are the vowels and vowel sequences a syllable may carry; each
+// vowel of a sequence is its own syllable.
+var Nuclei = []string{
+	"a", "e", "i", "o", "u",
+	"ai", "ae", "ao", "au", "ei", "io", "oa", "oi", "ou", "ua", "ui",
+}
+
+// Onsets lists the consonants, with "" (twice) for vowel-initial syllables.
+func (l Language) Onsets() []string {
+	return append([]string{"", ""}, l.Consonants...)
+}
+
+// Inventory exposes the lists and syllable parts for api.EstimateSpace.
+func (c *Culture) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      c.Male,
+		FirstFemale:    c.Female,
+		FirstNeutral:   c.Neutral,
+		Last:           c.Surnames,
+		Onsets:         c.Lang.Onsets(),
+		Nuclei:         Nuclei,
+		Codas:          []string{""},
+		GivenEndings:   c.GivenEndings,
+		SurnameEndings: c.SurnameEndings,
+	}
+}
+
+func (c *Culture) word(r api.RandLike, syllables int, endings []string, endPct int) string {
+	onsets := c.Lang.Onsets()
+	var b strings.Builder
+	for range syllables {
+		b.WriteString(api.PickRand(onsets, r) + api.PickRand(Nuclei, r))
+	}
+	if api.Chance(r, endPct) {
+		b.WriteString(api.PickRand(endings, r))
+	}
+	return b.String()
+}
+
+// Generate picks curated names or builds (C)V names, validated against the
+// language and marked with the ʻokina and macrons.
+func (c *Culture) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	marks := MarkRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
+
+	var err error
+	first := ""
+	if api.Chance(r, useRealPct) {
+		switch cfg.Gender {
+		case "male":
+			first = api.PickRand(c.Male, r)
+		case "female":
+			first = api.PickRand(c.Female, r)
+		default:
+			first = api.PickRand(c.Neutral, r)
+		}
+	} else {
+		first, err = c.Procedural(marks, func() string {
+			n := 2 + r.Intn(3) // 2..4
+			return c.word(r, n, c.GivenEndings, 35)
+		})
+		if err != nil {
+			return api.NameResult{}, err
+		}
+	}
+
+	last := ""
+	if cfg.IncludeLast {
+		if api.Chance(r, useRealPct) {
+			last = api.PickRand(c.Surnames, r)
+		} else {
+			last, err = c.Procedural(marks, func() string {
+				n := 3 + r.Intn(2) // 3..4
+				return c.word(r, n, c.SurnameEndings, 45)
+			})
+			if err != nil {
+				return api.NameResult{}, err
+			}
+		}
+	}
+	return api.NameResult{First: first, Last: last}, nil
+}
+
+// maxTries bounds how often Procedural regenerates an invalid name.
+const maxTries = 16
+
+// Procedural runs gen until the language accepts the name, then title-cases
+// and marks it. Generators that only use the language's letters pass at
+// once; the check keeps them honest, and a generator that keeps failing
+// gets the last validation error rather than an invalid name.
+func (c *Culture) Procedural(marks api.RandLike, gen func() string) (string, error) {
+	var err error
+	for range maxTries {
+		name := gen()
+		if err = c.Lang.Validate(name); err == nil {
+			return c.Lang.Mark(marks, api.Title(name)), nil
+		}
+	}
+	return "", err
+}
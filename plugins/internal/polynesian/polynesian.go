@@ -0,0 +1,190 @@
+// Package polynesian holds the phonotactics shared by the Polynesian
+// profiles (hawaiian, maori, samoan, tongan, tahitian): each language's
+// alphabet, a validator for its strict (C)V syllables, and the ʻokina and
+// macrons (Hawaiian kahakō) of the written names.
+package polynesian
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Okina is the glottal stop letter (Hawaiian ʻokina, Samoan koma liliu,
+// Tongan fakauʻa, Tahitian ʻeta).
+const Okina = "ʻ"
+
+// ErrPhonotactics reports a name its language cannot spell.
+var ErrPhonotactics = errors.New("polynesian: invalid name")
+
+// Language is one Polynesian orthography. Every syllable is a consonant
+// (or none) and one vowel, long vowels written with a macron.
+type Language struct {
+	Name       string
+	Consonants []string // letters and digraphs ("ng", "wh"), without the ʻokina
+	Loans      []string // letters only loanwords use, allowed in curated names
+	Okina      bool     // the glottal stop is written
+}
+
+var (
+	Hawaiian = Language{Name: "Hawaiian", Consonants: []string{"h", "k", "l", "m", "n", "p", "w"}, Okina: true}
+	Maori    = Language{Name: "Māori", Consonants: []string{"h", "k", "m", "n", "p", "r", "t", "w", "ng", "wh"}}
+	// Samoan writes the velar nasal g; h, k and r are for loanwords (Kelepi).
+	Samoan   = Language{Name: "Samoan", Consonants: []string{"f", "g", "l", "m", "n", "p", "s", "t", "v"}, Loans: []string{"h", "k", "r"}, Okina: true}
+	Tongan   = Language{Name: "Tongan", Consonants: []string{"f", "h", "k", "l", "m", "n", "ng", "p", "s", "t", "v"}, Okina: true}
+	Tahitian = Language{Name: "Tahitian", Consonants: []string{"f", "h", "m", "n", "p", "r", "t", "v"}, Okina: true}
+)
+
+// Vowels, plain and long.
+var (
+	vowels  = []string{"a", "e", "i", "o", "u"}
+	macrons = map[string]string{"a": "ā", "e": "ē", "i": "ī", "o": "ō", "u": "ū"}
+)
+
+func isVowel(s string) bool {
+	if slices.Contains(vowels, s) {
+		return true
+	}
+	for _, long := range macrons {
+		if s == long {
+			return true
+		}
+	}
+	return false
+}
+
+// consonant returns the consonant s starts with, longest first, or "".
+func (l Language) consonant(s string) string {
+	if l.Okina && strings.HasPrefix(s, Okina) {
+		return Okina
+	}
+	found := ""
+	for _, c := range l.Consonants {
+		if strings.HasPrefix(s, c) && len(c) > len(found) {
+			found = c
+		}
+	}
+	return found
+}
+
+// Syllables splits one word into its (C)V syllables. It fails on a letter
+// outside the alphabet, two consonants in a row, a final consonant or a
+// vowel written three times running ("Losaaa").
+func (l Language) Syllables(word string) ([]string, error) {
+	s := strings.ToLower(word)
+	var out []string
+	run, prev := 0, ""
+	for s != "" {
+		c := l.consonant(s)
+		rest := s[len(c):]
+		v, size := utf8.DecodeRuneInString(rest)
+		if rest == "" || !isVowel(string(v)) {
+			if c == "" {
+				return nil, fmt.Errorf("%w: %q: %q is not a %s letter", ErrPhonotactics, word, string(v), l.Name)
+			}
+			return nil, fmt.Errorf("%w: %q: %q is not followed by a vowel", ErrPhonotactics, word, c)
+		}
+		switch v := plain(string(v)); {
+		case c != "" || v != prev:
+			run, prev = 1, v
+		case run == 2:
+			return nil, fmt.Errorf("%w: %q: %q three times running", ErrPhonotactics, word, v)
+		default:
+			run++
+		}
+		out = append(out, c+rest[:size])
+		s = rest[size:]
+	}
+	return out, nil
+}
+
+// plain returns a vowel without its macron.
+func plain(v string) string {
+	for short, long := range macrons {
+		if v == long {
+			return short
+		}
+	}
+	return v
+}
+
+// Validate checks every word of name (separated by spaces or hyphens)
+// against the language.
+func (l Language) Validate(name string) error {
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return r == ' ' || r == '-' }) {
+		if _, err := l.Syllables(word); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Curated is the language with its loan letters, for validating curated
+// names (Samoan Kelepi); procedural names use l itself.
+func (l Language) Curated() Language {
+	l.Consonants = slices.Concat(l.Consonants, l.Loans)
+	l.Loans = nil
+	return l
+}
+
+// MustValidate panics if a curated name breaks the language's rules, loan
+// letters allowed; the profiles call it on their lists at init.
+func (l Language) MustValidate(lists ...[]string) {
+	curated := l.Curated()
+	for _, list := range lists {
+		for _, name := range list {
+			if err := curated.Validate(name); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// Chances, in percent, that Mark writes an ʻokina before a vowel that
+// starts a word or follows another vowel, and that a vowel is long.
+const (
+	okinaInitialPct = 15
+	okinaHiatusPct  = 20
+	macronPct       = 10
+)
+
+// Mark adds the ʻokina and long vowels to a procedural name spelled with
+// plain letters. r should not be the profile's main RNG, so that folding
+// the result to ASCII gives the name unmarked names had.
+func (l Language) Mark(r api.RandLike, name string) string {
+	var b strings.Builder
+	prev := ""
+	for _, ch := range name {
+		s := string(ch)
+		lower := strings.ToLower(s)
+		if isVowel(lower) {
+			if l.Okina && ((prev == "" && api.Chance(r, okinaInitialPct)) || (isVowel(prev) && api.Chance(r, okinaHiatusPct))) {
+				b.WriteString(Okina)
+			}
+			if long, ok := macrons[lower]; ok && lower != prev && api.Chance(r, macronPct) {
+				if s != lower {
+					long = strings.ToUpper(long)
+				}
+				s = long
+			}
+		}
+		b.WriteString(s)
+		prev = lower
+		if ch == ' ' || ch == '-' {
+			prev = ""
+		}
+	}
+	return b.String()
+}
+
+// MarkRand is the RNG for Mark, derived from the name's seed.
+func MarkRand(cfg api.ProfileConfig) api.RandLike {
+	if cfg.Seed != 0 {
+		cfg.Seed = api.DeriveSeed(cfg.Seed, "polynesian", "marks")
+	}
+	return api.NewRand(cfg)
+}
@@ -0,0 +1,183 @@
+package polynesian_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/hawaiian"
+	"github.com/nsa-yoda/namegen/plugins/internal/polynesian"
+	"github.com/nsa-yoda/namegen/plugins/maori"
+	"github.com/nsa-yoda/namegen/plugins/samoan"
+	"github.com/nsa-yoda/namegen/plugins/tahitian"
+	"github.com/nsa-yoda/namegen/plugins/tongan"
+)
+
+func TestSyllables(t *testing.T) {
+	tests := []struct {
+		lang polynesian.Language
+		word string
+		want []string // nil: invalid
+	}{
+		// Hawaiian: ʻokina, kahakō, w but no t, r or final consonant.
+		{polynesian.Hawaiian, "Kalani", []string{"ka", "la", "ni"}},
+		{polynesian.Hawaiian, "Keʻala", []string{"ke", "ʻa", "la"}},
+		{polynesian.Hawaiian, "ʻAukai", []string{"ʻa", "u", "ka", "i"}},
+		{polynesian.Hawaiian, "Kāne", []string{"kā", "ne"}},
+		{polynesian.Hawaiian, "ŌPŪ", []string{"ō", "pū"}},
+		{polynesian.Hawaiian, "Kalan", nil},
+		{polynesian.Hawaiian, "Tama", nil},
+		{polynesian.Hawaiian, "Kristo", nil},
+		{polynesian.Hawaiian, "Kaʻ", nil},
+
+		// Māori: the digraphs wh and ng, macrons, no ʻokina.
+		{polynesian.Maori, "Whetū", []string{"whe", "tū"}},
+		{polynesian.Maori, "Ngaio", []string{"nga", "i", "o"}},
+		{polynesian.Maori, "Wharengaro", []string{"wha", "re", "nga", "ro"}},
+		{polynesian.Maori, "Tāne", []string{"tā", "ne"}},
+		{polynesian.Maori, "Keʻala", nil},
+		{polynesian.Maori, "Lani", nil},
+		{polynesian.Maori, "Wiremun", nil},
+		{polynesian.Maori, "Whng", nil},
+
+		// Samoan: g is the velar nasal; loanword k and r only in curated
+		// names.
+		{polynesian.Samoan, "Galu", []string{"ga", "lu"}},
+		{polynesian.Samoan, "Seʻe", []string{"se", "ʻe"}},
+		{polynesian.Samoan, "Kelepi", nil},
+		{polynesian.Samoan.Curated(), "Kelepi", []string{"ke", "le", "pi"}},
+		{polynesian.Samoan, "Sefon", nil},
+		{polynesian.Samoan, "Ngalu", nil},
+
+		// No vowel three times running, unless an ʻokina or macron
+		// separates them.
+		{polynesian.Samoan, "Losaaa", nil},
+		{polynesian.Samoan, "Voaaa", nil},
+		{polynesian.Hawaiian, "Kaāa", nil},
+		{polynesian.Hawaiian, "Kaʻaa", []string{"ka", "ʻa", "a"}},
+		{polynesian.Maori, "Māaori", []string{"mā", "a", "o", "ri"}},
+
+		// Tongan: ng, ʻokina.
+		{polynesian.Tongan, "Ngata", []string{"nga", "ta"}},
+		{polynesian.Tongan, "ʻAna", []string{"ʻa", "na"}},
+		{polynesian.Tongan, "Siosaia", []string{"si", "o", "sa", "i", "a"}},
+		{polynesian.Tongan, "Wiki", nil},
+		{polynesian.Tongan, "Tevit", nil},
+
+		// Tahitian: r, ʻeta, no k or l.
+		{polynesian.Tahitian, "Teva", []string{"te", "va"}},
+		{polynesian.Tahitian, "Rāʻau", []string{"rā", "ʻa", "u"}},
+		{polynesian.Tahitian, "Kalo", nil},
+		{polynesian.Tahitian, "Hinat", nil},
+	}
+	for _, tt := range tests {
+		got, err := tt.lang.Syllables(tt.word)
+		switch {
+		case tt.want == nil:
+			if err == nil {
+				t.Errorf("%s %q: got %q, want an error", tt.lang.Name, tt.word, got)
+			} else if !errors.Is(err, polynesian.ErrPhonotactics) {
+				t.Errorf("%s %q: error %v is not ErrPhonotactics", tt.lang.Name, tt.word, err)
+			}
+		case err != nil:
+			t.Errorf("%s %q: %v", tt.lang.Name, tt.word, err)
+		case !reflect.DeepEqual(got, tt.want):
+			t.Errorf("%s %q: got %q, want %q", tt.lang.Name, tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		lang  polynesian.Language
+		name  string
+		valid bool
+	}{
+		{polynesian.Maori, "Hine-i-te-ao", true},
+		{polynesian.Maori, "Te Whetū", true},
+		{polynesian.Maori, "Te Whetūn", false},
+		{polynesian.Hawaiian, "Kalani Keʻala", true},
+		{polynesian.Hawaiian, "Kalani Smith", false},
+		{polynesian.Samoan, "Tusitala", true},
+		{polynesian.Tongan, "Salote Tupou", true},
+		{polynesian.Tahitian, "Hina-rere", true},
+		{polynesian.Tahitian, "Hina-rere-k", false},
+	}
+	for _, tt := range tests {
+		if err := tt.lang.Validate(tt.name); (err == nil) != tt.valid {
+			t.Errorf("%s Validate(%q) = %v, want valid %v", tt.lang.Name, tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestProcedural(t *testing.T) {
+	c := &polynesian.Culture{Lang: polynesian.Maori}
+	marks := rand.New(rand.NewSource(1))
+
+	calls := 0
+	name, err := c.Procedural(marks, func() string {
+		calls++
+		if calls < 3 {
+			return "kristo"
+		}
+		return "whetu"
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("Procedural = %q, %v after %d calls; want a name after 3", name, err, calls)
+	}
+	if err := c.Lang.Validate(name); err != nil || name[0] != 'W' {
+		t.Errorf("Procedural = %q (%v), want a valid title-cased name", name, err)
+	}
+
+	if name, err := c.Procedural(marks, func() string { return "kristo" }); !errors.Is(err, polynesian.ErrPhonotactics) {
+		t.Errorf("Procedural on a generator that always fails = %q, %v; want ErrPhonotactics", name, err)
+	}
+}
+
+// TestGeneratedNamesValidate checks the profiles' names, marked and folded
+// to ASCII, against their language: curated ones may use loan letters,
+// procedural ones may not.
+func TestGeneratedNamesValidate(t *testing.T) {
+	profiles := []struct {
+		p    api.NameProfile
+		lang polynesian.Language
+	}{
+		{hawaiian.Profile, polynesian.Hawaiian},
+		{maori.Profile, polynesian.Maori},
+		{samoan.Profile, polynesian.Samoan},
+		{tongan.Profile, polynesian.Tongan},
+		{tahitian.Profile, polynesian.Tahitian},
+	}
+	for _, tp := range profiles {
+		inv := tp.p.(api.Inventoried).Inventory()
+		curated := map[string]bool{}
+		for _, n := range slices.Concat(inv.FirstMale, inv.FirstFemale, inv.FirstNeutral, inv.Last) {
+			curated[n], curated[api.FoldASCII(n)] = true, true
+		}
+		for _, realism := range []int{0, 30, 60, 100} {
+			for _, gender := range []string{"male", "female", "neutral"} {
+				for _, ascii := range []bool{false, true} {
+					for seed := int64(1); seed <= 200; seed++ {
+						cfg := api.ProfileConfig{Seed: seed, Realism: realism, Gender: gender, IncludeLast: true, ASCII: ascii}
+						res, err := api.Generate(tp.p, cfg)
+						if err != nil {
+							t.Fatalf("%s: %v", tp.lang.Name, err)
+						}
+						for _, name := range []string{res.First, res.Last} {
+							lang := tp.lang
+							if curated[name] {
+								lang = lang.Curated()
+							}
+							if err := lang.Validate(name); err != nil {
+								t.Errorf("%s seed %d realism %d: %v", tp.lang.Name, seed, realism, err)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
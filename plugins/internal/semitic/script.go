@@ -0,0 +1,153 @@
+package semitic
+
+import "strings"
+
+// Script names the profiles accept in ProfileConfig.Script.
+const (
+	ScriptHebrew = "hebrew"
+	ScriptSyriac = "syriac"
+)
+
+// Maqaf is the Hebrew hyphen (Ben-Ami -> בן־עמי).
+const Maqaf = "־"
+
+// finals maps the five letters with a word-final form to it: כ מ נ פ צ ->
+// ך ם ן ף ץ.
+var finals = map[rune]rune{'כ': 'ך', 'מ': 'ם', 'נ': 'ן', 'פ': 'ף', 'צ': 'ץ'}
+
+// medials is finals reversed.
+var medials = map[rune]rune{'ך': 'כ', 'ם': 'מ', 'ן': 'נ', 'ף': 'פ', 'ץ': 'צ'}
+
+func isHebrewLetter(ch rune) bool { return ch >= 'א' && ch <= 'ת' }
+
+// Finalize writes each letter of a Hebrew string in its position's form: the
+// final form at the end of a word, the ordinary one inside it. Spellings can
+// then be joined freely: נתן + אל -> נתנאל.
+func Finalize(s string) string {
+	rs := []rune(s)
+	for i, ch := range rs {
+		end := i+1 == len(rs) || !isHebrewLetter(rs[i+1])
+		if f, ok := finals[ch]; ok && end {
+			rs[i] = f
+		} else if m, ok := medials[ch]; ok && !end {
+			rs[i] = m
+		}
+	}
+	return string(rs)
+}
+
+// syriac maps the 22 letters of the square script to the Syriac abjad, one
+// to one; Syriac letters take their final shape in rendering, so the final
+// forms map like the others.
+var syriac = map[rune]rune{
+	'א': 'ܐ', 'ב': 'ܒ', 'ג': 'ܓ', 'ד': 'ܕ', 'ה': 'ܗ', 'ו': 'ܘ', 'ז': 'ܙ', 'ח': 'ܚ',
+	'ט': 'ܛ', 'י': 'ܝ', 'כ': 'ܟ', 'ך': 'ܟ', 'ל': 'ܠ', 'מ': 'ܡ', 'ם': 'ܡ', 'נ': 'ܢ',
+	'ן': 'ܢ', 'ס': 'ܣ', 'ע': 'ܥ', 'פ': 'ܦ', 'ף': 'ܦ', 'צ': 'ܨ', 'ץ': 'ܨ', 'ק': 'ܩ',
+	'ר': 'ܪ', 'ש': 'ܫ', 'ת': 'ܬ', '־': '-',
+}
+
+// Syriac writes a Hebrew-script spelling in the Syriac alphabet:
+// יוחנן -> ܝܘܚܢܢ.
+func Syriac(s string) string {
+	return strings.Map(func(ch rune) rune {
+		if sy, ok := syriac[ch]; ok {
+			return sy
+		}
+		return ch
+	}, s)
+}
+
+// Render writes a Hebrew-script spelling in script: Hebrew with its final
+// forms, or Syriac.
+func Render(s, script string) string {
+	if script == ScriptSyriac {
+		return Syriac(s)
+	}
+	return Finalize(s)
+}
+
+// consonants maps the romanization's consonants to letters, digraphs
+// first. Where a sound has two letters (t ת/ט, k כ/ק, h ה/ח) the common
+// one is used; curated names carry their own spelling.
+var consonants = []struct{ lat, heb string }{
+	{"sh", "ש"}, {"ch", "ח"}, {"kh", "כ"}, {"tz", "צ"}, {"ts", "צ"}, {"th", "ת"}, {"ph", "פ"},
+	{"b", "ב"}, {"c", "ק"}, {"d", "ד"}, {"f", "פ"}, {"g", "ג"}, {"h", "ה"}, {"j", "ג׳"},
+	{"k", "כ"}, {"l", "ל"}, {"m", "מ"}, {"n", "נ"}, {"p", "פ"}, {"q", "ק"}, {"r", "ר"},
+	{"s", "ס"}, {"t", "ת"}, {"v", "ב"}, {"w", "ו"}, {"x", "קס"}, {"y", "י"}, {"z", "ז"},
+	{"'", "א"},
+}
+
+func isVowel(ch byte) bool { return strings.IndexByte("aeiou", ch) >= 0 }
+
+// Spell writes a romanized name in Hebrew letters, for the names no list
+// spells: consonants by the table above, i as yod and o, u as vav (the
+// matres lectionis), a and e unwritten inside a word and he at its end, an
+// initial vowel carried by alef, a hyphen as maqaf.
+func Spell(roman string) string {
+	var b strings.Builder
+	for i, word := range strings.Fields(roman) {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		for j, part := range strings.Split(word, "-") {
+			if j > 0 {
+				b.WriteString(Maqaf)
+			}
+			b.WriteString(spellWord(strings.ToLower(part)))
+		}
+	}
+	return Finalize(b.String())
+}
+
+func spellWord(w string) string {
+	var b strings.Builder
+	last := "" // the last consonant written, to write doubled ones once
+	for i := 0; i < len(w); {
+		ch := w[i]
+		if !isVowel(ch) {
+			matched := false
+			for _, c := range consonants {
+				if !strings.HasPrefix(w[i:], c.lat) {
+					continue
+				}
+				// A final h after a vowel only marks it (Sarah).
+				if c.lat == "h" && i+1 == len(w) && i > 0 && isVowel(w[i-1]) {
+					matched = true
+					i++
+					break
+				}
+				if c.heb != last {
+					b.WriteString(c.heb)
+				}
+				last, matched = c.heb, true
+				i += len(c.lat)
+				break
+			}
+			if !matched {
+				i++ // not a letter of the romanization
+			}
+			continue
+		}
+		// A vowel, or a pair written as one: ai, ei -> י; ou and doubled
+		// vowels (aa, ee) -> one.
+		j := i + 1
+		if j < len(w) && (w[j] == ch || (w[j] == 'i' && (ch == 'a' || ch == 'e')) || (ch == 'o' && w[j] == 'u')) {
+			j++
+		}
+		final := j == len(w) || (j+1 == len(w) && w[j] == 'h')
+		if i == 0 || (i > 0 && isVowel(w[i-1])) {
+			b.WriteString("א") // the vowel starts a syllable of its own
+		}
+		switch {
+		case ch == 'i' || (j-i == 2 && w[i+1] == 'i'):
+			b.WriteString("י")
+		case ch == 'o' || ch == 'u':
+			b.WriteString("ו")
+		case final:
+			b.WriteString("ה")
+		}
+		last = ""
+		i = j
+	}
+	return b.String()
+}
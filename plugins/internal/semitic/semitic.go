@@ -0,0 +1,162 @@
+// Package semitic holds what the hebrew and aramaic profiles share:
+// theophoric names, patronymics, and the Hebrew and Syriac scripts.
+//
+// Names are built with their spelling in the Hebrew (square) script
+// alongside the romanization. The square script and the Syriac alphabet
+// are the same 22-letter abjad, so one spelling serves both: Render gives
+// it its final letter forms (ך ם ן ף ץ) in Hebrew, or maps it to Syriac.
+//
+// Theophoric names join a divine element to a verb or noun: El- and
+// Yeho- (Yo-) before it, -el and -yahu (-iah) after it: Elnatan,
+// Yehonatan, Netanel, Netanyahu, Nethaniah are all "God gave".
+package semitic
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Name is a romanized name with its spelling in Hebrew letters.
+type Name struct {
+	Roman, Hebrew string
+}
+
+// Lexicon spells curated names in Hebrew letters, keyed by romanization.
+type Lexicon map[string]string
+
+// Spell gives a name's Hebrew-letter spelling: each word from the lexicon,
+// or by Spell when it has none.
+func (lx Lexicon) Spell(roman string) string {
+	if heb, ok := lx[roman]; ok {
+		return heb
+	}
+	words := strings.Fields(roman)
+	for i, w := range words {
+		if heb, ok := lx[w]; ok {
+			words[i] = heb
+		} else {
+			words[i] = Spell(w)
+		}
+	}
+	return Finalize(strings.Join(words, " "))
+}
+
+// Name looks up a curated name's spelling.
+func (lx Lexicon) Name(roman string) Name {
+	return Name{Roman: roman, Hebrew: lx.Spell(roman)}
+}
+
+// Root is the element a divine name is joined to, in its form after a
+// prefix (Yehonatan) and before a suffix (Netanel); either may be empty
+// where the root is not used that way. Only, when set, is the one affix
+// the root takes (Yocheved, not Elcheved; Hodaya, not Hodaela).
+type Root struct {
+	Pre, Post Name
+	Only      string
+}
+
+// Theophory is a language's theophoric elements.
+type Theophory struct {
+	Prefixes []Name // El-, Yeho-, Yo-
+	Suffixes []Name // -el first, then -yahu or -iah
+
+	// Women's names: suffixes (-ela, -ya) on the roots' Post forms, and
+	// roots of their own (Elisheva, Yocheved).
+	FemaleSuffixes []Name
+	FemaleRoots    []Root
+
+	Roots []Root
+}
+
+// Compose builds a theophoric given name for gender. Neutral names take
+// the -el suffix (Ariel, Uriel).
+func (t *Theophory) Compose(r api.RandLike, gender string) Name {
+	switch gender {
+	case "male":
+		root := api.PickRand(t.Roots, r)
+		if root.Pre.Roman != "" && (root.Post.Roman == "" || api.Chance(r, 50)) {
+			return Join(pick(r, t.Prefixes, root.Only), root.Pre)
+		}
+		return Join(root.Post, pick(r, t.Suffixes, root.Only))
+	case "female":
+		if len(t.FemaleRoots) > 0 {
+			root := api.PickRand(t.FemaleRoots, r)
+			if root.Pre.Roman != "" && (root.Post.Roman == "" || api.Chance(r, 50)) {
+				return Join(pick(r, t.Prefixes, root.Only), root.Pre)
+			}
+			if len(t.FemaleSuffixes) > 0 {
+				return Join(root.Post, pick(r, t.FemaleSuffixes, root.Only))
+			}
+		}
+	}
+	var posts []Root
+	for _, root := range t.Roots {
+		if root.Post.Roman != "" && (root.Only == "" || root.Only == t.Suffixes[0].Roman) {
+			posts = append(posts, root)
+		}
+	}
+	return Join(api.PickRand(posts, r).Post, t.Suffixes[0])
+}
+
+// pick picks an affix: the one named only, or any.
+func pick(r api.RandLike, affixes []Name, only string) Name {
+	for _, a := range affixes {
+		if a.Roman == only {
+			return a
+		}
+	}
+	return api.PickRand(affixes, r)
+}
+
+// Affix makes a theophoric name of a procedural root: a prefix, or a
+// suffix of the gender's (Kavorel, Elkavor, Kavorela). Women's names are
+// left as they are where the language has no women's suffixes.
+func (t *Theophory) Affix(r api.RandLike, gender string, root Name) Name {
+	switch {
+	case gender == "female" && len(t.FemaleSuffixes) > 0:
+		return Join(root, api.PickRand(t.FemaleSuffixes, r))
+	case gender == "female":
+		return root
+	case gender == "male" && api.Chance(r, 35):
+		return Join(api.PickRand(t.Prefixes, r), root)
+	case gender == "male":
+		return Join(root, api.PickRand(t.Suffixes, r))
+	}
+	return Join(root, t.Suffixes[0])
+}
+
+// Join writes two elements as one name. A vowel or a mater (י, ו) met by
+// its like at the join is written once: Uri + iah -> Uriah, אורי + יהו ->
+// אוריהו.
+func Join(a, b Name) Name {
+	ar, br := strings.ToLower(a.Roman), strings.ToLower(b.Roman)
+	if ar != "" && br != "" && ar[len(ar)-1] == br[0] && isVowel(br[0]) {
+		br = br[1:]
+	}
+	ah, bh := []rune(a.Hebrew), []rune(b.Hebrew)
+	if len(ah) > 0 && len(bh) > 0 && ah[len(ah)-1] == bh[0] && (bh[0] == 'י' || bh[0] == 'ו') {
+		bh = bh[1:]
+	}
+	return Name{Roman: api.Title(ar + br), Hebrew: Finalize(string(ah) + string(bh))}
+}
+
+// Particles are a language's words for "son of" and "daughter of".
+type Particles struct {
+	Son, Daughter Name
+}
+
+var (
+	Hebrew  = Particles{Son: Name{Roman: "ben", Hebrew: "בן"}, Daughter: Name{Roman: "bat", Hebrew: "בת"}}
+	Aramaic = Particles{Son: Name{Roman: "bar", Hebrew: "בר"}, Daughter: Name{Roman: "barat", Hebrew: "ברת"}}
+)
+
+// Patronymic names a child of parent: ben David, bat David, bar Yosef,
+// barat Yosef. Neutral takes the son's particle.
+func (p Particles) Patronymic(parent Name, gender string) Name {
+	link := p.Son
+	if gender == "female" {
+		link = p.Daughter
+	}
+	return Name{Roman: link.Roman + " " + parent.Roman, Hebrew: Finalize(link.Hebrew + " " + parent.Hebrew)}
+}
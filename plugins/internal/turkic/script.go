@@ -0,0 +1,86 @@
+package turkic
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Script names the profiles accept in ProfileConfig.Script.
+const ScriptCyrillic = "cyrillic"
+
+// kazakhLatin is the 2021 Kazakh Latin alphabet, letter by letter from
+// Cyrillic. и and й are both i (İ), і is dotless ı (I).
+var kazakhLatin = map[rune]string{
+	'а': "a", 'ә': "ä", 'б': "b", 'в': "v", 'г': "g", 'ғ': "ğ", 'д': "d", 'е': "e",
+	'ё': "io", 'ж': "j", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'қ': "q", 'л': "l",
+	'м': "m", 'н': "n", 'ң': "ñ", 'о': "o", 'ө': "ö", 'п': "p", 'р': "r", 'с': "s",
+	'т': "t", 'у': "u", 'ұ': "ū", 'ү': "ü", 'ф': "f", 'х': "h", 'һ': "h", 'ц': "ts",
+	'ч': "ç", 'ш': "ş", 'щ': "şş", 'ъ': "", 'ы': "y", 'і': "ı", 'ь': "", 'э': "e",
+	'ю': "iu", 'я': "ia",
+}
+
+// KazakhLatin writes a Kazakh name from Cyrillic in the 2021 Latin
+// alphabet: Нұрсұлтан -> Nūrsūltan, Айгерім -> Aigerım.
+func KazakhLatin(s string) string {
+	var b strings.Builder
+	for _, ch := range s {
+		lower := unicode.ToLower(ch)
+		lat, ok := kazakhLatin[lower]
+		if !ok {
+			b.WriteRune(ch)
+			continue
+		}
+		if ch != lower {
+			lat = upperFirst(lat)
+		}
+		b.WriteString(lat)
+	}
+	return b.String()
+}
+
+// uzbekCyrillic maps Uzbek Latin letters and digraphs to Cyrillic, longest
+// first. An initial e is э; ye, yo, yu and ya are е, ё, ю and я.
+var uzbekCyrillic = []struct{ lat, cyr string }{
+	{"yoʻ", "йў"}, {"oʻ", "ў"}, {"gʻ", "ғ"}, {"sh", "ш"}, {"ch", "ч"},
+	{"ye", "е"}, {"yo", "ё"}, {"yu", "ю"}, {"ya", "я"}, {"ʼ", "ъ"},
+	{"a", "а"}, {"b", "б"}, {"d", "д"}, {"e", "е"}, {"f", "ф"}, {"g", "г"},
+	{"h", "ҳ"}, {"i", "и"}, {"j", "ж"}, {"k", "к"}, {"l", "л"}, {"m", "м"},
+	{"n", "н"}, {"o", "о"}, {"p", "п"}, {"q", "қ"}, {"r", "р"}, {"s", "с"},
+	{"t", "т"}, {"u", "у"}, {"v", "в"}, {"x", "х"}, {"y", "й"}, {"z", "з"},
+}
+
+// UzbekCyrillic writes an Uzbek name from Latin in Cyrillic:
+// Oʻlmas Gʻafurov -> Ўлмас Ғафуров, Abdullayev -> Абдуллаев.
+func UzbekCyrillic(s string) string {
+	var b strings.Builder
+	start := true // at the start of a word
+	for s != "" {
+		ch, size := utf8.DecodeRuneInString(s)
+		upper := unicode.IsUpper(ch)
+		lower := strings.ToLower(s)
+		matched := false
+		for _, m := range uzbekCyrillic {
+			if !strings.HasPrefix(lower, m.lat) {
+				continue
+			}
+			cyr := m.cyr
+			if m.lat == "e" && start {
+				cyr = "э"
+			}
+			if upper {
+				cyr = upperFirst(cyr)
+			}
+			b.WriteString(cyr)
+			s = s[len(m.lat):]
+			matched = true
+			break
+		}
+		if !matched {
+			b.WriteRune(ch)
+			s = s[size:]
+		}
+		start = !matched && !unicode.IsLetter(ch)
+	}
+	return b.String()
+}
@@ -0,0 +1,340 @@
+// Package turkic is the phonology shared by the Turkic profiles (turkish,
+// kazakh, uzbek): vowel harmony for procedural names and their suffixes,
+// the gendered surname endings, and the Latin and Cyrillic orthographies.
+//
+// Words are built in each language's working orthography: Turkish Latin,
+// Kazakh Cyrillic (rendered in the 2021 Latin alphabet by KazakhLatin) and
+// Uzbek Latin (rendered in Cyrillic by UzbekCyrillic).
+//
+// Suffix templates harmonize with the word they follow:
+//
+//   - {A} is the low vowel: a after back vowels, e after front ones
+//   - {I} is the high vowel: ı/i, and u/ü after a rounded vowel in Turkish
+//   - {C} is c, or ç after a voiceless consonant (Turkish Demirci, Sütçü)
+//   - {OV} is the Russian-style -ov, or -ev after a vowel (Nazarbaev)
+//
+// A template starting with "+" is a word of its own joined on (-oğlu,
+// -ұлы, -бек): it keeps its vowels, as it does in the languages.
+package turkic
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Language is one Turkic language's vowel system and syllable parts.
+type Language struct {
+	Name string
+
+	// Vowel letters by row, and those outside harmony (Kazakh и, у).
+	Back, Front, Neutral string
+	Rounded              string
+
+	Harmony  bool // a word's vowels share one row, front or back
+	Rounding bool // high vowels after a rounded vowel are rounded (Turkish)
+
+	// Realizations of {A} (back, front) and {I} (back, front, then
+	// rounded back and front).
+	A [2]string
+	I [4]string
+
+	// Velars maps back consonants to their front counterparts (Kazakh қ/к,
+	// ғ/г); words and suffixes use the one of their row.
+	Velars map[string]string
+
+	Voiceless string    // consonants after which {C} is ç
+	Ov        [2]string // {OV} after a consonant and after a vowel
+	Glide     string    // the y a {OV} after a vowel absorbs (Nazarbai -> Nazarbaev)
+
+	// Syllable parts. Nuclei are the first syllable's vowels; later
+	// syllables take {A} or {I}.
+	Onsets, Nuclei, Codas []string
+}
+
+var (
+	Turkish = Language{
+		Name: "Turkish",
+		Back: "aıou", Front: "eiöü", Rounded: "ouöü",
+		Harmony: true, Rounding: true,
+		A:         [2]string{"a", "e"},
+		I:         [4]string{"ı", "i", "u", "ü"},
+		Voiceless: "çfhkpsşt",
+		Onsets: []string{
+			"", "",
+			"b", "c", "ç", "d", "f", "g", "h", "k", "l", "m", "n", "p", "r", "s", "ş", "t", "v", "y", "z",
+		},
+		Nuclei: []string{"a", "a", "e", "e", "ı", "i", "i", "o", "ö", "u", "ü"},
+		Codas:  []string{"", "", "", "n", "m", "r", "l", "k", "t", "s", "ş", "z", "y", "ğ"},
+	}
+
+	Kazakh = Language{
+		Name: "Kazakh",
+		Back: "аоұыя", Front: "әеөүіэ", Neutral: "иую", Rounded: "оұөү",
+		Harmony: true,
+		A:       [2]string{"а", "е"},
+		I:       [4]string{"ы", "і", "ы", "і"},
+		Velars:  map[string]string{"қ": "к", "ғ": "г"},
+		Ov:      [2]string{"ов", "ев"},
+		Glide:   "й",
+		Onsets: []string{
+			"", "",
+			"б", "д", "ж", "з", "қ", "ғ", "л", "м", "н", "п", "р", "с", "т", "ш", "й", "х",
+		},
+		Nuclei: []string{"а", "а", "а", "е", "е", "ы", "і", "о", "ө", "ұ", "ү", "ә"},
+		Codas:  []string{"", "", "", "", "н", "м", "р", "л", "т", "қ", "с", "ң", "й", "у", "ш", "з"},
+	}
+
+	// Uzbek lost vowel harmony; its names are built from the same parts
+	// without it.
+	Uzbek = Language{
+		Name: "Uzbek",
+		Back: "aoiue", Rounded: "ou",
+		A:     [2]string{"a", "a"},
+		I:     [4]string{"i", "i", "i", "i"},
+		Ov:    [2]string{"ov", "yev"},
+		Glide: "y",
+		Onsets: []string{
+			"", "",
+			"b", "d", "f", "g", "h", "j", "k", "l", "m", "n", "p", "q", "r", "s", "t", "v", "x", "y", "z",
+			"sh", "ch", "gʻ",
+		},
+		Nuclei: []string{"a", "a", "o", "o", "i", "i", "u", "e", "oʻ"},
+		Codas:  []string{"", "", "", "n", "m", "r", "l", "t", "k", "s", "sh", "q", "x", "z"},
+	}
+)
+
+// row of a word: the row and rounding of its last harmonizing vowel. ok is
+// false for words without one.
+func (l *Language) row(word string) (front, round, ok bool) {
+	for _, ch := range strings.ToLower(word) {
+		switch {
+		case strings.ContainsRune(l.Back, ch):
+			front, ok = false, true
+		case strings.ContainsRune(l.Front, ch):
+			front, ok = true, true
+		default:
+			continue
+		}
+		round = strings.ContainsRune(l.Rounded, ch)
+	}
+	return front, round, ok
+}
+
+// firstRow is the row of the first harmonizing vowel of a word.
+func (l *Language) firstRow(word string) (front, ok bool) {
+	for _, ch := range strings.ToLower(word) {
+		switch {
+		case strings.ContainsRune(l.Back, ch):
+			return false, true
+		case strings.ContainsRune(l.Front, ch):
+			return true, true
+		}
+	}
+	return false, false
+}
+
+func (l *Language) isVowel(ch rune) bool {
+	return strings.ContainsRune(l.Back, ch) || strings.ContainsRune(l.Front, ch) || strings.ContainsRune(l.Neutral, ch)
+}
+
+// endsInVowel reports a word ending in a vowel letter.
+func (l *Language) endsInVowel(word string) bool {
+	ch, _ := utf8.DecodeLastRuneInString(strings.ToLower(word))
+	return l.isVowel(ch)
+}
+
+// velars puts the velar consonants of s in the given row.
+func (l *Language) velars(s string, front bool) string {
+	for back, fr := range l.Velars {
+		if front {
+			s = strings.ReplaceAll(s, back, fr)
+		} else {
+			s = strings.ReplaceAll(s, fr, back)
+		}
+	}
+	return s
+}
+
+// vowel picks a later syllable's vowel after one of the given row and
+// rounding: the low vowel, or the high vowel (rounded after a rounded one
+// where the language has rounding harmony).
+func (l *Language) vowel(r api.RandLike, front, round bool) string {
+	if !l.Harmony {
+		return api.PickRand(l.Nuclei, r)
+	}
+	if api.Chance(r, 55) {
+		return l.low(front)
+	}
+	return l.high(front, round)
+}
+
+func (l *Language) low(front bool) string {
+	if front {
+		return l.A[1]
+	}
+	return l.A[0]
+}
+
+func (l *Language) high(front, round bool) string {
+	i := 0
+	if front {
+		i = 1
+	}
+	if round && l.Rounding {
+		i += 2
+	}
+	return l.I[i]
+}
+
+// Word builds a lowercase procedural word of n syllables obeying the
+// language's harmony. A syllable after an open one always has an onset.
+func (l *Language) Word(r api.RandLike, n int) string {
+	var b strings.Builder
+	var front, round bool
+	for i := range n {
+		onset := api.PickRand(l.Onsets, r)
+		for onset == "" && i > 0 && l.endsInVowel(b.String()) {
+			onset = api.PickRand(l.Onsets, r)
+		}
+		v := ""
+		if i == 0 {
+			v = api.PickRand(l.Nuclei, r)
+			front, round, _ = l.row(v)
+		} else {
+			v = l.vowel(r, front, round)
+			if _, rd, ok := l.row(v); ok {
+				round = rd
+			}
+		}
+		b.WriteString(onset + v + api.PickRand(l.Codas, r))
+	}
+	if !l.Harmony {
+		return b.String()
+	}
+	return l.velars(b.String(), front)
+}
+
+// Attach adds a suffix template to word (see the package comment).
+func (l *Language) Attach(word, suffix string) string {
+	suffix, joined := strings.CutPrefix(suffix, "+")
+	front, round, _ := l.row(word)
+	if joined {
+		// A word of its own: its first vowel sets the row.
+		if fr, ok := l.firstRow(strings.NewReplacer("{A}", "", "{I}", "").Replace(suffix)); ok {
+			front, round = fr, false
+		}
+	} else if l.endsInVowel(word) && (strings.HasPrefix(suffix, "{A}") || strings.HasPrefix(suffix, "{I}")) {
+		// No vowel meets another at the join: Kaya + {A}r -> Kayar.
+		suffix = suffix[len("{A}"):]
+	}
+
+	out, start := word, len(word)
+	for suffix != "" {
+		switch {
+		case strings.HasPrefix(suffix, "{A}"):
+			v := l.low(front)
+			out += v
+			round = strings.Contains(l.Rounded, v)
+			suffix = suffix[len("{A}"):]
+		case strings.HasPrefix(suffix, "{I}"):
+			out += l.high(front, round)
+			suffix = suffix[len("{I}"):]
+		case strings.HasPrefix(suffix, "{C}"):
+			last, _ := utf8.DecodeLastRuneInString(strings.ToLower(out))
+			if strings.ContainsRune(l.Voiceless, last) {
+				out += "ç"
+			} else {
+				out += "c"
+			}
+			suffix = suffix[len("{C}"):]
+		case strings.HasPrefix(suffix, "{OV}"):
+			after := l.endsInVowel(out)
+			if l.Glide != "" && strings.HasSuffix(strings.ToLower(out), l.Glide) {
+				out, after = out[:len(out)-len(l.Glide)], true
+				start = min(start, len(out))
+			}
+			if after {
+				out += l.Ov[1]
+			} else {
+				out += l.Ov[0]
+			}
+			suffix = suffix[len("{OV}"):]
+		default:
+			ch, size := utf8.DecodeRuneInString(suffix)
+			out += string(ch)
+			if fr, rd, ok := l.row(string(ch)); ok {
+				front, round = fr, rd
+			}
+			suffix = suffix[size:]
+		}
+	}
+	if !l.Harmony {
+		return out
+	}
+	return out[:start] + l.velars(out[start:], front)
+}
+
+// Agrees reports whether suffix can follow word under vowel harmony:
+// templates and joined words always can, fixed suffixes only when their
+// vowels are of the word's row.
+func (l *Language) Agrees(word, suffix string) bool {
+	if !l.Harmony || strings.HasPrefix(suffix, "+") || strings.Contains(suffix, "{") {
+		return true
+	}
+	wf, _, wok := l.row(word)
+	sf, sok := l.firstRow(suffix)
+	return !wok || !sok || wf == sf
+}
+
+// Ending picks a suffix from endings that agrees with word and attaches it.
+func (l *Language) Ending(r api.RandLike, word string, endings []string) string {
+	var ok []string
+	for _, e := range endings {
+		if l.Agrees(word, e) {
+			ok = append(ok, e)
+		}
+	}
+	if len(ok) == 0 {
+		return word
+	}
+	return l.Attach(word, api.PickRand(ok, r))
+}
+
+// Feminine returns surname with a woman's ending in place of the man's, from
+// pairs of masculine and feminine endings tried in order (-ov -> -ova,
+// -uly -> -qyzy). Surnames without one are returned unchanged.
+func Feminine(surname string, pairs [][2]string) string {
+	lower := strings.ToLower(surname)
+	for _, p := range pairs {
+		if strings.HasSuffix(lower, p[0]) {
+			return surname[:len(surname)-len(p[0])] + p[1]
+		}
+	}
+	return surname
+}
+
+// Title title-cases each word with the Turkic dotted and dotless i
+// (irem -> İrem, ılgın -> Ilgın).
+func Title(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		parts := strings.Split(w, "-")
+		for j, p := range parts {
+			parts[j] = upperFirst(strings.ToLowerSpecial(unicode.TurkishCase, p))
+		}
+		words[i] = strings.Join(parts, "-")
+	}
+	return strings.Join(words, " ")
+}
+
+// upperFirst capitalizes the first letter of s, with dotted İ for i.
+func upperFirst(s string) string {
+	ch, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.TurkishCase.ToUpper(ch)) + s[size:]
+}
@@ -1,11 +1,10 @@
 package italian
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type italianProfile struct{}
@@ -23,6 +22,49 @@ func (p italianProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p italianProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the abbreviated Italian titles (Sig.ra, Dott.ssa) and Don.
+func (p italianProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sig.", Gender: "male", Weight: 45},
+			{Text: "Sig.ra", Gender: "female", Weight: 40},
+			{Text: "Sig.na", Gender: "female", Weight: 5},
+			{Text: "Dott.", Gender: "male", Weight: 12},
+			{Text: "Dott.ssa", Gender: "female", Weight: 12},
+			{Text: "Avv.", Weight: 4},
+			{Text: "Ing.", Weight: 4},
+			{Text: "Prof.", Gender: "male", Weight: 2},
+			{Text: "Prof.ssa", Gender: "female", Weight: 2},
+			{Text: "Don", Gender: "male", Weight: 1, Use: api.UseGiven},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms (Giuseppe -> Beppe) and the
+// endings -ino/-ina and -etto/-etta.
+func (p italianProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"ino", "etto"},
+	}
+}
+
 // Curated given names.
 var firstMale = []string{
 	"Marco", "Luca", "Matteo", "Giovanni", "Francesco", "Alessandro", "Andrea", "Giorgio", "Paolo", "Stefano",
@@ -63,9 +105,98 @@ var givenEndingsNeutral = []string{"", "", "", "a", "e", "i"}
 
 var surnameEndings = []string{"", "", "", "i", "o", "a", "ini", "etti", "elli", "one", "aro"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"giuseppe":   {"Beppe", "Peppe", "Pino", "Peppino"},
+	"francesco":  {"Checco", "Franco", "Cesco"},
+	"giovanni":   {"Gianni", "Nino", "Vanni"},
+	"salvatore":  {"Toto", "Turi"},
+	"vincenzo":   {"Enzo", "Vincè"},
+	"alessandro": {"Sandro", "Ale"},
+	"antonio":    {"Toni", "Totò", "Nino"},
+	"federico":   {"Fede", "Chicco"},
+	"riccardo":   {"Ricky"},
+	"leonardo":   {"Leo"},
+	"massimo":    {"Max"},
+	"roberto":    {"Robi"},
+	"emanuele":   {"Manu", "Lele"},
+	"daniele":    {"Dani", "Lele"},
+	"pietro":     {"Piero"},
+	"filippo":    {"Pippo"},
+	"michele":    {"Michi"},
+	"maria":      {"Mariuccia"},
+	"francesca":  {"Fra", "Checca"},
+	"valentina":  {"Vale"},
+	"alessia":    {"Ale"},
+	"elisabetta": {"Betta", "Lisa"},
+	"giulia":     {"Giuli"},
+	"federica":   {"Fede"},
+	"caterina":   {"Rina", "Cate"},
+	"beatrice":   {"Bice", "Bea"},
+	"giorgia":    {"Gio"},
+}
+
+func genSyl(r api.RandLike) string {
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+	return b.String()
+}
+
 func (p italianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -92,69 +223,9 @@ func (p italianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -171,28 +242,28 @@ func (p italianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
 		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		} else {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
@@ -1,11 +1,10 @@
 package japanese
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type japaneseProfile struct{}
@@ -19,57 +18,214 @@ func init() {
 func (p japaneseProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Japanese names: realism blends curated romaji lists with kana-like procedural syllables; deterministic with seed",
+		"notes": "Japanese names built from morae: realism blends curated names (with kanji) and procedural morae with gendered endings; romanizations hepburn (default), hepburn-macrons, kunrei, nihon-shiki; scripts kanji, hiragana, katakana",
 	}
 }
 
-// Curated romaji lists (expand whenever you want).
-// These are common/recognizable enough to feel “real” without being huge datasets.
+// Inventory exposes the curated lists (in Hepburn) and morae for
+// api.EstimateSpace.
+func (p japaneseProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      romanizeAll(firstMale),
+		FirstFemale:    romanizeAll(firstFemale),
+		FirstNeutral:   romanizeAll(firstNeutral),
+		Last:           romanizeAll(lastNames),
+		NeutralMixes:   true,
+		Nuclei:         romanizeAll(slices.Concat(plainMorae, voicedMorae, yoonMorae)),
+		GivenEndings:   romanizeAll(slices.Concat(givenEndings["male"], givenEndings["female"], givenEndings["neutral"])),
+		SurnameEndings: romanizeAll(surnameEndings),
+	}
+}
+
+func romanizeAll(kana []string) []string {
+	out := make([]string, len(kana))
+	for i, k := range kana {
+		out[i] = romanize(k, romanHepburn)
+	}
+	return out
+}
+
+// Forms gives the suffixed honorifics -san, -sama, -sensei, -kun and -chan.
+func (p japaneseProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "san", Weight: 70, After: true, Join: "-"},
+			{Text: "sama", Weight: 10, After: true, Join: "-"},
+			{Text: "sensei", Weight: 8, After: true, Join: "-"},
+			{Text: "kun", Gender: "male", Weight: 8, After: true, Join: "-"},
+			{Text: "chan", Gender: "female", Weight: 8, Use: api.UseGiven, After: true, Join: "-"},
+		},
+	}
+}
+
+// NicknameRules adds -chan to the whole name and to its open first
+// syllable; there are no curated nicknames.
+func (p japaneseProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Suffixes: []string{"-chan"},
+		OpenStem: true,
+		NoClip:   true,
+	}
+}
+
+// Curated given names and surnames in hiragana; "|" marks a morpheme
+// boundary where vowels do not merge (いの|うえ, Inoue).
 var firstMale = []string{
-	"Haruto", "Yuto", "Sota", "Yuki", "Koki", "Ren", "Kaito", "Takumi", "Daiki", "Ryota",
-	"Yuma", "Riku", "Shota", "Tatsuya", "Kenta", "Keita", "Kazuki", "Shinji", "Hiroshi", "Taro",
-	"Kenji", "Naoki", "Koji", "Masato", "Yusuke", "Hayato", "Shun", "Minato", "Itsuki", "Sora",
+	"はると", "ゆうと", "そうた", "ゆうき", "こうき", "れん", "かいと", "たくみ", "だいき", "りょうた",
+	"ゆうま", "りく", "しょうた", "たつや", "けんた", "けいた", "かずき", "しんじ", "ひろし", "たろう",
+	"けんじ", "なおき", "こうじ", "まさと", "ゆうすけ", "はやと", "しゅん", "みなと", "いつき", "そら",
 }
 
 var firstFemale = []string{
-	"Yui", "Aoi", "Sakura", "Hina", "Rin", "Mio", "Yuna", "Akari", "Hana", "Mei",
-	"Nanami", "Rina", "Ayaka", "Haruka", "Miku", "Misaki", "Kaori", "Emi", "Nozomi", "Yoko",
-	"Keiko", "Sachiko", "Naoko", "Maki", "Chihiro", "Reina", "Sumire", "Koharu", "Saki", "Natsumi",
+	"ゆい", "あおい", "さくら", "ひな", "りん", "みお", "ゆな", "あかり", "はな", "めい",
+	"ななみ", "りな", "あやか", "はるか", "みく", "みさき", "かおり", "えみ", "のぞみ", "ようこ",
+	"けいこ", "さちこ", "なおこ", "まき", "ちひろ", "れいな", "すみれ", "こはる", "さき", "なつみ",
 }
 
 var firstNeutral = []string{
-	"Akira", "Hikaru", "Kaoru", "Makoto", "Nao", "Rei", "Ryo", "Sora", "Yu", "Haruka",
+	"あきら", "ひかる", "かおる", "まこと", "なお", "れい", "りょう", "そら", "ゆう", "はるか",
 }
 
 var lastNames = []string{
-	"Sato", "Suzuki", "Takahashi", "Tanaka", "Watanabe", "Ito", "Yamamoto", "Nakamura", "Kobayashi", "Kato",
-	"Yoshida", "Yamada", "Sasaki", "Yamaguchi", "Matsumoto", "Inoue", "Kimura", "Hayashi", "Shimizu", "Yamazaki",
-	"Morita", "Okada", "Abe", "Fujita", "Ishikawa", "Hashimoto", "Ikeda", "Maeda", "Fukuda", "Ota",
+	"さとう", "すずき", "たかはし", "たなか", "わたなべ", "いとう", "やまもと", "なかむら", "こばやし", "かとう",
+	"よしだ", "やまだ", "ささき", "やまぐち", "まつもと", "いの|うえ", "きむら", "はやし", "しみず", "やまざき",
+	"もりた", "おかだ", "あべ", "ふじた", "いしかわ", "はしもと", "いけだ", "まえだ", "ふくだ", "おおた",
+}
+
+// Kanji spellings of curated readings; a name's kanji form is drawn from
+// these, and names without an entry are written in kana.
+var givenKanji = map[string][]string{
+	"はると": {"陽翔", "悠斗", "大翔"}, "ゆうと": {"悠斗", "優斗"}, "そうた": {"颯太", "蒼太"}, "ゆうき": {"勇気", "悠希", "優樹"},
+	"こうき": {"光輝", "幸樹"}, "れん": {"蓮"}, "かいと": {"海斗", "快斗"}, "たくみ": {"匠", "拓海"}, "だいき": {"大輝", "大樹"},
+	"りょうた": {"涼太", "亮太"}, "ゆうま": {"悠真", "佑真"}, "りく": {"陸"}, "しょうた": {"翔太"}, "たつや": {"達也", "竜也"},
+	"けんた": {"健太"}, "けいた": {"啓太", "圭太"}, "かずき": {"和樹", "一輝"}, "しんじ": {"真司", "慎二"}, "ひろし": {"博", "浩"},
+	"たろう": {"太郎"}, "けんじ": {"健二", "賢治"}, "なおき": {"直樹"}, "こうじ": {"浩二", "康二"}, "まさと": {"正人", "雅人"},
+	"ゆうすけ": {"祐介", "雄介"}, "はやと": {"隼人"}, "しゅん": {"駿", "俊"}, "みなと": {"湊"}, "いつき": {"樹", "一輝"},
+	"そら": {"空", "蒼空"},
+	"ゆい": {"結衣", "唯"}, "あおい": {"葵"}, "さくら": {"桜", "咲良"}, "ひな": {"陽菜", "日菜"}, "りん": {"凛"},
+	"みお": {"澪", "美桜"}, "ゆな": {"結菜", "優奈"}, "あかり": {"朱里", "明里"}, "はな": {"花", "華"}, "めい": {"芽衣", "芽依"},
+	"ななみ": {"七海"}, "りな": {"里奈", "莉奈"}, "あやか": {"彩花", "綾香"}, "はるか": {"遥", "春香"}, "みく": {"未来", "美玖"},
+	"みさき": {"美咲"}, "かおり": {"香織"}, "えみ": {"恵美", "絵美"}, "のぞみ": {"希", "望"}, "ようこ": {"陽子", "洋子"},
+	"けいこ": {"恵子", "慶子"}, "さちこ": {"幸子"}, "なおこ": {"直子", "尚子"}, "まき": {"真紀", "麻紀"}, "ちひろ": {"千尋"},
+	"れいな": {"玲奈"}, "すみれ": {"菫"}, "こはる": {"小春"}, "さき": {"咲", "早紀"}, "なつみ": {"夏美"},
+	"あきら": {"明", "晃"}, "ひかる": {"光"}, "かおる": {"薫"}, "まこと": {"誠"}, "なお": {"直", "奈央"},
+	"れい": {"玲", "怜"}, "りょう": {"涼", "亮"}, "ゆう": {"優", "悠"},
+}
+
+var surnameKanji = map[string][]string{
+	"さとう": {"佐藤"}, "すずき": {"鈴木"}, "たかはし": {"高橋"}, "たなか": {"田中"}, "わたなべ": {"渡辺", "渡部"},
+	"いとう": {"伊藤"}, "やまもと": {"山本"}, "なかむら": {"中村"}, "こばやし": {"小林"}, "かとう": {"加藤"},
+	"よしだ": {"吉田"}, "やまだ": {"山田"}, "ささき": {"佐々木"}, "やまぐち": {"山口"}, "まつもと": {"松本"},
+	"いの|うえ": {"井上"}, "きむら": {"木村"}, "はやし": {"林"}, "しみず": {"清水"}, "やまざき": {"山崎"},
+	"もりた": {"森田"}, "おかだ": {"岡田"}, "あべ": {"阿部", "安倍"}, "ふじた": {"藤田"}, "いしかわ": {"石川"},
+	"はしもと": {"橋本"}, "いけだ": {"池田"}, "まえだ": {"前田"}, "ふくだ": {"福田"}, "おおた": {"太田"},
+}
+
+// --- Procedural morae ---
+var plainMorae = []string{
+	"あ", "い", "う", "え", "お", "か", "き", "く", "け", "こ", "さ", "し", "す", "せ", "そ",
+	"た", "ち", "つ", "て", "と", "な", "に", "ぬ", "ね", "の", "は", "ひ", "ふ", "へ", "ほ",
+	"ま", "み", "む", "め", "も", "や", "ゆ", "よ", "ら", "り", "る", "れ", "ろ", "わ",
+}
+
+var voicedMorae = []string{
+	"が", "ぎ", "ぐ", "げ", "ご", "ざ", "じ", "ず", "ぜ", "ぞ", "だ", "で", "ど",
+	"ば", "び", "ぶ", "べ", "ぼ", "ぱ", "ぴ", "ぷ", "ぺ", "ぽ",
 }
 
-// --- Procedural syllables ---
-// Keep these “Japanese-feeling” (simple CV / some common clusters).
-var vowels = []string{"a", "i", "u", "e", "o"}
+var yoonMorae = []string{
+	"きゃ", "きゅ", "きょ", "しゃ", "しゅ", "しょ", "ちゃ", "ちゅ", "ちょ", "にゃ", "にゅ", "にょ",
+	"ひゃ", "ひゅ", "ひょ", "みゃ", "みゅ", "みょ", "りゃ", "りゅ", "りょ", "ぎゃ", "ぎゅ", "ぎょ",
+	"じゃ", "じゅ", "じょ", "びゃ", "びゅ", "びょ",
+}
+
+// Typical given-name endings by gender: -ta, -to, -suke, -ya for boys,
+// -ko, -mi, -ka, -na for girls.
+var givenEndings = map[string][]string{
+	"male":    {"た", "と", "すけ", "き", "や", "じ", "ろう", "へい", "ま", "お", "いち", "ご"},
+	"female":  {"こ", "み", "か", "な", "え", "よ", "り", "ほ", "の", "は", "さ", "き"},
+	"neutral": {"る", "き", "ら", "と", "み", "り", "や"},
+}
+
+var surnameEndings = []string{"もと", "やま", "かわ", "ざき", "むら", "なか", "した", "がわ", "だ", "た", "の", "もり", "はし", "しま", "はら"}
+
+// genMora draws one mora: mostly plain, sometimes voiced, and at higher
+// realism now and then a yōon; it may be lengthened (おう, ゆう) or
+// closed with ん.
+func genMora(r api.RandLike, realism int) string {
+	m := api.PickRand(plainMorae, r)
+	switch roll := r.Intn(100); {
+	case roll < 15:
+		m = api.PickRand(voicedMorae, r)
+	case roll < 25 && realism >= 50:
+		m = api.PickRand(yoonMorae, r)
+	}
+	switch roll := r.Intn(100); {
+	case roll < 8 && (strings.HasSuffix(romanize(m, romanKunrei), "o") || strings.HasSuffix(romanize(m, romanKunrei), "u")):
+		m += "う"
+	case roll < 18:
+		m += "ん"
+	}
+	return m
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	// 1-2 morae before the ending; lower realism sometimes 1-3 and no ending
+	numMorae := 1 + r.Intn(2)
+	if realism < 40 {
+		numMorae = 1 + r.Intn(3)
+	}
 
-var consonantOnsets = []string{
-	"k", "s", "t", "n", "h", "m", "y", "r", "w",
-	"g", "z", "d", "b", "p",
+	var b strings.Builder
+	for i := 0; i < numMorae; i++ {
+		b.WriteString(genMora(r, realism))
+	}
+	if realism < 40 && r.Intn(100) < 30 {
+		return b.String()
+	}
+
+	endings, ok := givenEndings[cfg.Gender]
+	if !ok {
+		endings = givenEndings["neutral"]
+	}
+	end := api.PickRand(endings, r)
+	if !strings.HasSuffix(b.String(), end) {
+		b.WriteString(end)
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	// 2-3 morae, surname-like
+	numMorae := 2 + r.Intn(2)
+	var b strings.Builder
+	for i := 0; i < numMorae; i++ {
+		b.WriteString(genMora(r, realism))
+	}
+	// add a surname ending sometimes
+	if r.Intn(100) < 35 {
+		end := api.PickRand(surnameEndings, r)
+		if !strings.HasSuffix(b.String(), end) {
+			b.WriteString(boundary + end)
+		}
+	}
+	return b.String()
 }
 
-var clusters = []string{
-	"ky", "gy",
-	"sh", "ch", "j",
-	"ny", "hy", "by", "py", "my", "ry",
-	"ts",
+// Romanizations lists the romanization systems, plain Hepburn first.
+func (p japaneseProfile) Romanizations() []string {
+	return []string{romanHepburn, romanHepburnMacrons, romanKunrei, romanNihon}
 }
 
-// Some common endings to make results feel more name-like (still conservative).
-var givenEndings = []string{"", "", "", "to", "ta", "ki", "shi", "ya", "na", "ko"} // ko can occur in female names
-var surnameEndings = []string{"", "", "", "moto", "yama", "kawa", "zaki", "mura", "naka", "shita", "gawa"}
+// Scripts lists the native scripts; kanji falls back to hiragana for names
+// without a kanji spelling.
+func (p japaneseProfile) Scripts() []string {
+	return []string{"kanji", "hiragana", "katakana"}
+}
 
 func (p japaneseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	romanization := resolveRomanization(cfg.Romanization)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -100,114 +256,85 @@ func (p japaneseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 		useRealPct = 5
 	}
 
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	// ---- Procedural generators ----
-	genCV := func() string {
-		// 20% chance of cluster at higher realism; otherwise simple onset
-		onset := api.PickRand(consonantOnsets, r)
-		if realism >= 50 && r.Intn(100) < 20 {
-			onset = api.PickRand(clusters, r)
-		}
-		return onset + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		// 2–4 syllables normally; lower realism sometimes 1–3
-		numSyl := 2 + r.Intn(3) // 2..4
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genCV())
-		}
-
-		// occasional ending
-		end := api.PickRand(givenEndings, r)
-		if end != "" && !strings.HasSuffix(b.String(), end) {
-			b.WriteString(end)
-		}
-
-		// small gender nuance: if explicitly female and high realism, bias toward "-ko" sometimes
-		if cfg.Gender == "female" && realism >= 70 && r.Intn(100) < 15 {
-			s := b.String()
-			if !strings.HasSuffix(s, "ko") {
-				b.Reset()
-				b.WriteString(s)
-				b.WriteString("ko")
-			}
-		}
-
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		// 2–3 syllables surname-like
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genCV())
-		}
-		// add surname ending sometimes, more likely at higher realism
-		if r.Intn(100) < 35 {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
-
 	// ---- Choose first name ----
-	first := ""
-	if chooseFromReal() {
+	given := ""
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
-			first = api.PickRand(firstMale, r)
+			given = api.PickRand(firstMale, r)
 		case "female":
-			first = api.PickRand(firstFemale, r)
+			given = api.PickRand(firstFemale, r)
 		default:
 			roll := r.Intn(100)
 			if roll < 60 {
-				first = api.PickRand(firstNeutral, r)
+				given = api.PickRand(firstNeutral, r)
 			} else if roll < 80 {
-				first = api.PickRand(firstMale, r)
+				given = api.PickRand(firstMale, r)
 			} else {
-				first = api.PickRand(firstFemale, r)
+				given = api.PickRand(firstFemale, r)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		given = genGivenProcedural(r, cfg, realism)
 	}
 
 	// ---- Choose last name ----
-	last := ""
+	surname := ""
 	if cfg.IncludeLast {
-		// Allow cfg.Family override to force Japanese-style surname rules if you expand other modes later.
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSurnameProcedural())
-			}
+		if api.Chance(r, useRealPct) {
+			surname = api.PickRand(lastNames, r)
 		} else {
-			// If Family override is set to something else, still produce a Japanese-ish surname
-			// (keeps behavior stable, but you can refine later).
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSurnameProcedural())
-			}
+			surname = genSurnameProcedural(r, realism)
 		}
 	}
 
-	// final casing (safe if input already proper)
-	first = caser.String(first)
-	last = caser.String(last)
+	res := api.NameResult{First: romanize(given, romanization)}
+	if surname != "" {
+		res.Last = romanize(surname, romanization)
+	}
+	if script := api.NativeScript(p, cfg); script != "" {
+		res.Native = render(surname, given, script, r)
+	}
+	return res, nil
+}
+
+// render writes a name family-first in a native script. Kanji names are
+// written solid (山田太郎); kana, or kanji mixed with kana, keep a space
+// between the names (やまだ たろう).
+func render(surname, given, script string, r api.RandLike) string {
+	parts := []string{surname, given}
+	kanji := []map[string][]string{surnameKanji, givenKanji}
+	solid := script == "kanji"
+	for i, kana := range parts {
+		if kana == "" {
+			continue
+		}
+		if script == "kanji" {
+			if k, ok := kanji[i][kana]; ok {
+				parts[i] = api.PickRand(k, r)
+				continue
+			}
+			solid = false
+		}
+		parts[i] = strings.ReplaceAll(kana, boundary, "")
+		if script == "katakana" {
+			parts[i] = katakana(parts[i])
+		}
+	}
+	sep := " "
+	if solid || parts[0] == "" {
+		sep = ""
+	}
+	return parts[0] + sep + parts[1]
+}
 
-	return api.NameResult{First: first, Last: last}, nil
+// resolveRomanization turns a cfg.Romanization value into a system, plain
+// Hepburn by default.
+func resolveRomanization(romanization string) string {
+	if r, ok := romanAliases[strings.ToLower(strings.TrimSpace(romanization))]; ok {
+		return r
+	}
+	return romanHepburn
 }
 
 // Profile is the core exported symbol
@@ -0,0 +1,233 @@
+package japanese
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Names are kept as hiragana, one mora per kana (two for yōon such as
+// "きょ"), and romanized from that. A "|" marks a morpheme boundary where
+// two vowels must not merge into a long vowel (いの|うえ, Inoue).
+const boundary = "|"
+
+// gojuon rows: the row's consonant in Kunrei-shiki and its five kana
+// (a, i, u, e, o; "_" where the row has a gap).
+var gojuon = []struct {
+	consonant string
+	kana      string
+}{
+	{"", "あいうえお"},
+	{"k", "かきくけこ"},
+	{"s", "さしすせそ"},
+	{"t", "たちつてと"},
+	{"n", "なにぬねの"},
+	{"h", "はひふへほ"},
+	{"m", "まみむめも"},
+	{"y", "や_ゆ_よ"},
+	{"r", "らりるれろ"},
+	{"w", "わ___を"},
+	{"g", "がぎぐげご"},
+	{"z", "ざじずぜぞ"},
+	{"d", "だぢづでど"},
+	{"b", "ばびぶべぼ"},
+	{"p", "ぱぴぷぺぽ"},
+}
+
+// Hepburn spellings that differ from Kunrei-shiki.
+var hepburnSpellings = map[string]string{
+	"si": "shi", "ti": "chi", "tu": "tsu", "hu": "fu", "zi": "ji", "wo": "o",
+	"sya": "sha", "syu": "shu", "syo": "sho", "tya": "cha", "tyu": "chu", "tyo": "cho",
+	"zya": "ja", "zyu": "ju", "zyo": "jo",
+}
+
+// Romanization systems (cfg.Romanization).
+const (
+	romanHepburn        = "hepburn"         // long vowels unmarked, as on passports: Sato Yuto
+	romanHepburnMacrons = "hepburn-macrons" // Satō Yūto
+	romanKunrei         = "kunrei"          // Satô Yûto, Syôta
+	romanNihon          = "nihon-shiki"     // as Kunrei, but ぢ づ を stay di, du, wo
+)
+
+var romanAliases = map[string]string{
+	"hepburn": romanHepburn, "passport": romanHepburn,
+	"hepburn-macrons": romanHepburnMacrons, "macrons": romanHepburnMacrons,
+	"kunrei": romanKunrei, "kunrei-shiki": romanKunrei,
+	"nihon-shiki": romanNihon, "nihon": romanNihon, "nippon-shiki": romanNihon,
+}
+
+// mora holds the spellings of one mora: Hepburn, Kunrei, Nihon-shiki.
+type mora [3]string
+
+var morae = buildMorae()
+
+func buildMorae() map[string]mora {
+	out := map[string]mora{}
+	vowels := "aiueo"
+	for _, row := range gojuon {
+		for i, k := range []rune(row.kana) {
+			if k == '_' {
+				continue
+			}
+			nihon := row.consonant + vowels[i:i+1]
+			kunrei := nihon
+			switch nihon {
+			case "di", "du":
+				kunrei = "z" + nihon[1:]
+			case "wo":
+				kunrei = "o"
+			}
+			hepburn := kunrei
+			if h, ok := hepburnSpellings[nihon]; ok {
+				hepburn = h
+			}
+			out[string(k)] = mora{hepburn, kunrei, nihon}
+
+			// Yōon: i-column kana plus a small ya/yu/yo.
+			if i != 1 || row.consonant == "" || row.consonant == "y" || row.consonant == "w" || row.consonant == "d" {
+				continue
+			}
+			for j, small := range []string{"ゃ", "ゅ", "ょ"} {
+				y := row.consonant + "y" + "auo"[j:j+1]
+				hy := y
+				if h, ok := hepburnSpellings[y]; ok {
+					hy = h
+				}
+				out[string(k)+small] = mora{hy, y, y}
+			}
+		}
+	}
+	out["ん"] = mora{"n", "n", "n"}
+	return out
+}
+
+// splitMorae splits hiragana into morae; boundaries come back as "|".
+func splitMorae(kana string) []string {
+	rs := []rune(kana)
+	var out []string
+	for i := 0; i < len(rs); i++ {
+		if i+1 < len(rs) && strings.ContainsRune("ゃゅょ", rs[i+1]) {
+			out = append(out, string(rs[i:i+2]))
+			i++
+			continue
+		}
+		out = append(out, string(rs[i]))
+	}
+	return out
+}
+
+var (
+	macrons     = map[byte]string{'a': "ā", 'i': "ī", 'u': "ū", 'e': "ē", 'o': "ō"}
+	circumflexs = map[byte]string{'a': "â", 'i': "î", 'u': "û", 'e': "ê", 'o': "ô"}
+)
+
+// lengthens reports whether kana k after a mora ending in vowel v makes
+// that vowel long: aa, uu, ee, oo and ou. "ei" and "ii" are spelled out.
+func lengthens(v byte, k string) bool {
+	switch k {
+	case "あ":
+		return v == 'a'
+	case "う":
+		return v == 'u' || v == 'o'
+	case "え":
+		return v == 'e'
+	case "お":
+		return v == 'o'
+	}
+	return false
+}
+
+// romanize spells hiragana in a romanization system, capitalized:
+// sokuon doubles the next consonant (Hepburn "tch"), hatsuon takes an
+// apostrophe before a vowel or y (Shin'ya), and long vowels get a macron,
+// a circumflex or, in plain Hepburn, nothing.
+func romanize(kana, romanization string) string {
+	system := 0
+	switch romanization {
+	case romanKunrei:
+		system = 1
+	case romanNihon:
+		system = 2
+	}
+	ms := splitMorae(kana)
+	var b strings.Builder
+	last := byte(0) // vowel the output ends in, 0 after a consonant or boundary
+	for i, m := range ms {
+		switch {
+		case m == boundary:
+			last = 0
+			continue
+		case m == "っ":
+			if next := nextMora(ms, i, system); next != "" && !strings.ContainsRune("aiueo", rune(next[0])) {
+				if system == 0 && strings.HasPrefix(next, "ch") {
+					b.WriteByte('t')
+				} else {
+					b.WriteByte(next[0])
+				}
+			}
+			last = 0
+			continue
+		case last != 0 && lengthens(last, m):
+			out := b.String()
+			b.Reset()
+			b.WriteString(out[:len(out)-1])
+			switch romanization {
+			case romanHepburnMacrons:
+				b.WriteString(macrons[last])
+			case romanKunrei, romanNihon:
+				b.WriteString(circumflexs[last])
+			default:
+				b.WriteByte(last)
+			}
+			last = 0
+			continue
+		}
+		spelled, ok := morae[m]
+		if !ok {
+			continue
+		}
+		s := spelled[system]
+		b.WriteString(s)
+		last = 0
+		if m == "ん" {
+			if next := nextMora(ms, i, system); next != "" && strings.ContainsRune("aiueoy", rune(next[0])) {
+				b.WriteByte('\'')
+			}
+			continue
+		}
+		if c := s[len(s)-1]; strings.IndexByte("aiueo", c) >= 0 {
+			last = c
+		}
+	}
+	return capitalize(b.String())
+}
+
+// nextMora is the spelling of the mora after i, skipping boundaries.
+func nextMora(ms []string, i, system int) string {
+	for _, m := range ms[i+1:] {
+		if m == boundary {
+			continue
+		}
+		return morae[m][system]
+	}
+	return ""
+}
+
+// katakana converts hiragana to katakana.
+func katakana(kana string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'ぁ' && r <= 'ゖ' {
+			return r + 0x60
+		}
+		return r
+	}, kana)
+}
+
+// capitalize upper-cases the first letter of a romanized name.
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -1,11 +1,11 @@
 package kazakh
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/turkic"
 )
 
 type kazakhProfile struct{}
@@ -19,166 +19,230 @@ func init() {
 func (p kazakhProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Kazakh/Central Asian inspired names (ASCII): curated + procedural fallback; deterministic",
+		"notes": "Kazakh names in the 2021 Latin alphabet, or Cyrillic with -script cyrillic; harmonic procedural names; -ov/-ova and -ūly/-qyzy surnames",
 	}
 }
 
-// Curated: common Kazakh given names (ASCII transliteration).
-var givenMale = []string{
-	"Alikhan", "Nursultan", "Arman", "Bekzat", "Dias", "Erlan", "Yerlan", "Serik", "Timur", "Aidar",
-	"Kanat", "Daniyar", "Marat", "Nurbol", "Sanzhar", "Azamat", "Bolat", "Bauyrzhan", "Mukhtar", "Zhanibek",
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p kazakhProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         lang.Onsets,
+		Nuclei:         lang.Nuclei,
+		Codas:          lang.Codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
 }
 
-var givenFemale = []string{
-	"Aigul", "Aigerim", "Dana", "Dinara", "Gulnaz", "Madina", "Aruzhan", "Zarina", "Assel", "Aisulu",
-	"Malika", "Kamila", "Amina", "Sholpan", "Saule", "Gulnara", "Aliya", "Ainur", "Zhanna", "Karlygash",
+// Forms gives myrza, hanym, ağa and apai after the given name.
+func (p kazakhProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "myrza", Gender: "male", Weight: 40, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "hanym", Gender: "female", Weight: 40, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "ağa", Gender: "male", Weight: 20, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "apai", Gender: "female", Weight: 20, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Dr.", Weight: 4},
+		},
+	}
 }
 
-var givenNeutral = []string{
-	"Dana", "Amina", "Timur", "Arman", "Madina", "Aliya", "Dias", "Ainur", "Zarina", "Azamat",
+// Scripts lists the native script: Cyrillic.
+func (p kazakhProfile) Scripts() []string {
+	return []string{turkic.ScriptCyrillic}
 }
 
-// Curated surnames and common suffix styles.
-var surnames = []string{
-	"Nurpeisov", "Suleimenov", "Kudaibergenov", "Kenzhebekov", "Serikov", "Tursunov", "Abdullayev", "Iskakov",
-	"Zhaksylykov", "Omarov", "Akhmetov", "Beketov", "Zhaparov", "Sadykov", "Bekturov",
+// NicknameRules adds the endearments -jan and -ke to longer names; there
+// are no curated nicknames.
+func (p kazakhProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Suffixes: []string{"jan", "ke"},
+	}
 }
 
-// Procedural blocks (Turkic-ish; simplified, ASCII only).
-var onsets = []string{
-	"", "",
-	"b", "d", "g", "h", "j", "k", "l", "m", "n", "p", "q", "r", "s", "t", "v", "y", "z",
-	"zh", "sh", "kh", "ch",
-	"br", "kr", "tr",
+// Curated names are kept in Cyrillic, the spelling in use, and rendered in
+// the 2021 Latin alphabet (turkic.KazakhLatin).
+var givenMale = []string{
+	"Әлихан", "Нұрсұлтан", "Арман", "Бекзат", "Диас", "Ерлан", "Ержан", "Серік", "Тимур", "Айдар",
+	"Қанат", "Данияр", "Марат", "Нұрбол", "Санжар", "Азамат", "Болат", "Бауыржан", "Мұхтар", "Жәнібек",
 }
 
-var vowels = []string{
-	"a", "e", "i", "o", "u", "y",
-	"ai", "au", "ia", "ie", "oi", "ua",
+var givenFemale = []string{
+	"Айгүл", "Айгерім", "Дана", "Динара", "Гүлназ", "Мадина", "Аружан", "Зарина", "Әсел", "Айсұлу",
+	"Мәлика", "Камила", "Амина", "Шолпан", "Сәуле", "Гүлнара", "Әлия", "Айнұр", "Жанна", "Қарлығаш",
 }
 
-var codas = []string{
-	"", "", "", "",
-	"n", "m", "r", "l", "t", "k", "s",
-	"ng",
+var givenNeutral = []string{
+	"Дана", "Амина", "Тимур", "Арман", "Мадина", "Әлия", "Диас", "Айнұр", "Зарина", "Азамат",
 }
 
-var givenEndingsMale = []string{"", "", "", "bek", "khan", "bay", "mir", "nur", "lan"}
-var givenEndingsFemale = []string{"", "", "", "gul", "nur", "ai", "ana", "ya"}
-var givenEndingsNeutral = []string{"", "", "", "nur", "ai", "an"}
+// Curated surnames, in the men's form.
+var surnames = []string{
+	"Нұрпейісов", "Сүлейменов", "Құдайбергенов", "Кенжебеков", "Серіков", "Тұрсынов", "Абдуллаев", "Ысқақов",
+	"Жақсылықов", "Омаров", "Ахметов", "Бекетов", "Жапаров", "Садықов", "Бектұров",
+}
 
-var surnameEndings = []string{"ov", "ova", "ev", "eva", "bekov", "bayev", "uly", "kyzy"}
+// Procedural names follow Kazakh vowel harmony, velars included (қ/к,
+// ғ/г; see turkic). Endings are suffix templates.
+var lang = &turkic.Kazakh
 
-func (p kazakhProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
-	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+var givenEndingsMale = []string{"", "", "", "+бек", "+хан", "+бай", "+жан", "т{A}й", "{A}н"}
+var givenEndingsFemale = []string{"", "", "", "+гүл", "+нұр", "+ай", "+жан", "{A}"}
+var givenEndingsNeutral = []string{"", "", "", "+нұр", "+ай", "{A}н"}
 
-	realism := cfg.Realism
-	if realism < 0 {
-		realism = 0
+var surnameEndings = []string{"{OV}", "{OV}", "{OV}", "+бек{OV}", "+бай{OV}", "+ұлы"}
+
+// Women's surname endings, in Cyrillic and in Latin.
+var (
+	feminineCyrillic = [][2]string{{"ов", "ова"}, {"ев", "ева"}, {"ин", "ина"}, {"ұлы", "қызы"}}
+	feminineLatin    = [][2]string{{"ov", "ova"}, {"ev", "eva"}, {"in", "ina"}, {"ūly", "qyzy"}}
+)
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	n := 2
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	} else if r.Intn(100) < 30 {
+		n = 2 + r.Intn(2)
 	}
-	if realism > 100 {
-		realism = 100
+	word := lang.Word(r, n)
+	switch cfg.Gender {
+	case "male":
+		return lang.Ending(r, word, givenEndingsMale)
+	case "female":
+		return lang.Ending(r, word, givenEndingsFemale)
 	}
+	return lang.Ending(r, word, givenEndingsNeutral)
+}
 
-	useRealPct := 0
-	switch {
-	case realism >= 95:
-		useRealPct = 95
-	case realism >= 90:
-		useRealPct = 90
-	case realism >= 80:
-		useRealPct = 80
-	case realism >= 70:
-		useRealPct = 55
-	case realism >= 60:
-		useRealPct = 35
-	case realism >= 40:
-		useRealPct = 20
-	default:
-		useRealPct = 5
-	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 2
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		} else if r.Intn(100) < 30 {
-			n = 2 + r.Intn(2)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	word := lang.Word(r, n)
+	// Frequently add a surname suffix.
+	if r.Intn(100) < 80 {
+		return lang.Ending(r, word, surnameEndings)
+	}
+	return word
+}
 
-		switch cfg.Gender {
-		case "male":
-			b.WriteString(api.PickRand(givenEndingsMale, r))
-		case "female":
-			b.WriteString(api.PickRand(givenEndingsFemale, r))
-		default:
-			b.WriteString(api.PickRand(givenEndingsNeutral, r))
-		}
-		return b.String()
+// pickGiven picks a given name in Cyrillic and the gender it resolves to: a
+// neutral request that draws a curated man's or woman's name takes that
+// gender, and only procedural neutral names stay "".
+func pickGiven(r api.RandLike, cfg api.ProfileConfig, gender string, realism, useRealPct int) (string, string) {
+	if !api.Chance(r, useRealPct) {
+		cfg.Gender = gender
+		return api.Title(genGivenProcedural(r, cfg, realism)), gender
+	}
+	switch gender {
+	case "male":
+		return api.PickRand(givenMale, r), "male"
+	case "female":
+		return api.PickRand(givenFemale, r), "female"
 	}
+	roll := r.Intn(100)
+	if roll < 60 {
+		name := api.PickRand(givenNeutral, r)
+		return name, curatedGender(name)
+	} else if roll < 80 {
+		return api.PickRand(givenMale, r), "male"
+	}
+	return api.PickRand(givenFemale, r), "female"
+}
 
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
+// curatedGender is the gender of a curated given name, or "" when it is in
+// both lists or neither.
+func curatedGender(name string) string {
+	male, female := slices.Contains(givenMale, name), slices.Contains(givenFemale, name)
+	switch {
+	case male && !female:
+		return "male"
+	case female && !male:
+		return "female"
+	}
+	return ""
+}
 
-		// Frequently add a surname suffix.
-		if r.Intn(100) < 80 {
-			sfx := api.PickRand(surnameEndings, r)
-			// avoid double-suffix look
-			if !strings.HasSuffix(b.String(), sfx) {
-				b.WriteString(sfx)
-			}
-		}
-		return b.String()
+// patronymic forms the father's name + ұлы (son) or қызы (daughter).
+func patronymic(father, gender string) string {
+	if gender == "female" {
+		return father + "қызы"
 	}
+	return father + "ұлы"
+}
+
+func (p kazakhProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
 
-	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(givenMale, r)
-		case "female":
-			first = api.PickRand(givenFemale, r)
+	first, gender := pickGiven(r, cfg, cfg.Gender, realism, useRealPct)
+	res := api.NameResult{First: turkic.KazakhLatin(first)}
+	last := ""
+	if cfg.IncludeLast {
+		var parts *api.NameParts
+		switch strings.ToLower(strings.TrimSpace(cfg.Convention)) {
+		case api.ConventionPatronymic:
+			father, _ := pickGiven(r, cfg, "male", realism, useRealPct)
+			last = patronymic(father, gender)
+			parts = &api.NameParts{Convention: api.ConventionPatronymic, Father: turkic.KazakhLatin(father)}
 		default:
-			roll := r.Intn(100)
-			if roll < 60 {
-				first = api.PickRand(givenNeutral, r)
-			} else if roll < 80 {
-				first = api.PickRand(givenMale, r)
+			base := ""
+			if api.Chance(r, useRealPct) {
+				base = api.PickRand(surnames, r)
 			} else {
-				first = api.PickRand(givenFemale, r)
+				base = api.Title(genSurnameProcedural(r, realism))
 			}
+			form := surnameForm(gender)
+			last = base
+			if form == api.SurnameFeminine {
+				last = turkic.Feminine(base, feminineCyrillic)
+			}
+			parts = &api.NameParts{SurnameBase: turkic.KazakhLatin(base), SurnameForm: form}
 		}
-	} else {
-		first = caser.String(genGivenProcedural())
+		res.Last, res.Parts = turkic.KazakhLatin(last), parts
+	}
+	if api.NativeScript(p, cfg) != "" {
+		res.Native = strings.TrimSpace(first + " " + last)
 	}
+	return res, nil
+}
 
-	last := ""
-	if cfg.IncludeLast {
-		if chooseFromReal() {
-			last = api.PickRand(surnames, r)
-		} else {
-			last = caser.String(genSurnameProcedural())
-		}
+// surnameForm is the surname form for a name's gender; neutral keeps the
+// men's form.
+func surnameForm(gender string) api.SurnameForm {
+	switch gender {
+	case "male":
+		return api.SurnameMasculine
+	case "female":
+		return api.SurnameFeminine
+	}
+	return ""
+}
+
+// InflectSurname gives a woman's form of a Latin surname: -ova, -eva, -ina,
+// -qyzy.
+func (p kazakhProfile) InflectSurname(base string, form api.SurnameForm, _ string) string {
+	if form == "" || form == api.SurnameMasculine {
+		return base
 	}
+	return turkic.Feminine(base, feminineLatin)
+}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+// FormPatronymic lets family trees use the patronymic convention:
+// Nūrlanūly, Nūrlanqyzy.
+func (p kazakhProfile) FormPatronymic(parent string, _ bool, gender, _ string) string {
+	if gender == "female" {
+		return parent + "qyzy"
+	}
+	return parent + "ūly"
 }
 
 var Profile kazakhProfile
@@ -0,0 +1,93 @@
+package korean
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Hangul syllable block is composed from an initial consonant, a medial
+// vowel and an optional final consonant (Unicode's 19 x 21 x 28 table).
+const (
+	hangulBase   = 0xAC00
+	hangulLast   = 0xD7A3
+	medialCount  = 21
+	finalCount   = 28
+	initialIEUNG = 11 // ㅇ, silent as an initial
+)
+
+// Jamo in Unicode order, with how each system spells them.
+var (
+	initialJamo = []string{"ㄱ", "ㄲ", "ㄴ", "ㄷ", "ㄸ", "ㄹ", "ㅁ", "ㅂ", "ㅃ", "ㅅ", "ㅆ", "ㅇ", "ㅈ", "ㅉ", "ㅊ", "ㅋ", "ㅌ", "ㅍ", "ㅎ"}
+	medialJamo  = []string{"ㅏ", "ㅐ", "ㅑ", "ㅒ", "ㅓ", "ㅔ", "ㅕ", "ㅖ", "ㅗ", "ㅘ", "ㅙ", "ㅚ", "ㅛ", "ㅜ", "ㅝ", "ㅞ", "ㅟ", "ㅠ", "ㅡ", "ㅢ", "ㅣ"}
+
+	rrInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	rrMedials  = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	rrFinals   = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+
+	// McCune-Reischauer, word-initial (voiceless) forms; mrVoiced replaces
+	// them after a vowel or a voiced final.
+	mrInitials = []string{"k", "kk", "n", "t", "tt", "r", "m", "p", "pp", "s", "ss", "", "ch", "tch", "ch'", "k'", "t'", "p'", "h"}
+	mrVoiced   = map[int]string{0: "g", 3: "d", 7: "b", 12: "j"}
+	mrMedials  = []string{"a", "ae", "ya", "yae", "ŏ", "e", "yŏ", "ye", "o", "wa", "wae", "oe", "yo", "u", "wŏ", "we", "wi", "yu", "ŭ", "ŭi", "i"}
+)
+
+// block is a decomposed Hangul syllable, as jamo indices.
+type block struct {
+	initial, medial, final int
+}
+
+func (b block) String() string {
+	return string(rune(hangulBase + (b.initial*medialCount+b.medial)*finalCount + b.final))
+}
+
+// decompose splits a Hangul string into blocks; other runes are skipped.
+func decompose(s string) []block {
+	var out []block
+	for _, c := range s {
+		if c < hangulBase || c > hangulLast {
+			continue
+		}
+		i := int(c - hangulBase)
+		out = append(out, block{initial: i / (medialCount * finalCount), medial: i / finalCount % medialCount, final: i % finalCount})
+	}
+	return out
+}
+
+// rr spells a block in Revised Romanization. Names are romanized syllable
+// by syllable, without the sound changes across syllables.
+func (b block) rr() string {
+	return rrInitials[b.initial] + rrMedials[b.medial] + rrFinals[b.final]
+}
+
+// mr spells a block in McCune-Reischauer. voiced is true after a vowel or
+// a voiced final (n, m, ng, l) within the same name: Tae-jung, Chŏng-ŭn.
+func (b block) mr(voiced bool) string {
+	initial := mrInitials[b.initial]
+	if v, ok := mrVoiced[b.initial]; ok && voiced {
+		initial = v
+	}
+	medial := mrMedials[b.medial]
+	if initial == "s" && (medial == "i" || medial == "wi") {
+		initial = "sh"
+	}
+	return initial + medial + rrFinals[b.final]
+}
+
+// voicedFinal reports whether b ends in a vowel or n, l, m, ng.
+func (b block) voicedFinal() bool {
+	switch rrFinals[b.final] {
+	case "", "n", "l", "m", "ng":
+		return true
+	}
+	return false
+}
+
+// capitalize upper-cases the first letter of a romanized name.
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + strings.ToLower(s[size:])
+}
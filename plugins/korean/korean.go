@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type koreanProfile struct{}
@@ -19,56 +17,269 @@ func init() {
 func (p koreanProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Korean names (romanized): realism blends curated lists with procedural syllables; deterministic with seed",
+		"notes": "Korean names composed from Hangul syllables: realism blends curated lists, weighted given-name syllables and composed jamo; romanizations passport (default), revised, mccune-reischauer; Hangul with script native",
 	}
 }
 
-// Curated given names (romanized; ASCII only).
-// These are common-ish modern given names, not Hangul.
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p koreanProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    firstMale,
+		FirstFemale:  firstFemale,
+		FirstNeutral: firstNeutral,
+		Last:         lastNames,
+		NeutralMixes: true,
+		Onsets:       rrInitials,
+		Nuclei:       rrMedials,
+		Codas:        []string{"", "k", "n", "l", "m", "p", "ng"},
+	}
+}
+
+// Forms gives -ssi after the full name and -nim and seonsaengnim.
+func (p koreanProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "ssi", Weight: 60, Use: api.UseFull, After: true, Join: "-"},
+			{Text: "nim", Weight: 15, After: true, Join: "-"},
+			{Text: "seonsaengnim", Weight: 8, After: true, Join: " "},
+		},
+	}
+}
+
+// NicknameRules adds the vocative -ah or -ya to the whole name; there are
+// no curated nicknames.
+func (p koreanProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Suffixes: []string{"-ah|-ya"},
+		NoClip:   true,
+	}
+}
+
+// Curated given names in Hangul, romanized with cfg.Romanization.
 var firstMale = []string{
-	"Minjun", "Seojun", "Jiho", "Joon", "Hyunwoo", "Taehyun", "Junho", "Donghyun", "Seungmin", "Jisung",
-	"Hyun", "Sungmin", "Jinhyuk", "Jaehoon", "Wonjun", "Daehyun", "Kangmin", "Sangwoo", "Youngho", "Byungwoo",
-	"Jaewon", "Seungwoo", "Kihyun", "Sungwoo", "Hyeonjin", "Seongho", "Jinwoo", "Kyungsoo", "Inho", "Gunwoo",
+	"민준", "서준", "지호", "준", "현우", "태현", "준호", "동현", "승민", "지성",
+	"현", "성민", "진혁", "재훈", "원준", "대현", "강민", "상우", "영호", "병우",
+	"재원", "승우", "기현", "성우", "현진", "성호", "진우", "경수", "인호", "건우",
 }
 
 var firstFemale = []string{
-	"Seoyeon", "Seoah", "Jiwon", "Soojin", "Hyejin", "Yuna", "Minseo", "Jiyeon", "Eunji", "Soyeon",
-	"Hayoung", "Yeji", "Dahyun", "Seulgi", "Nayeon", "Jisoo", "Jieun", "Eunseo", "Chaeyoung", "Sumin",
-	"Yejin", "Hana", "Hyerin", "Jimin", "Bomin", "Sora", "Yuri", "Sena", "Mina", "Euna",
+	"서연", "서아", "지원", "수진", "혜진", "유나", "민서", "지연", "은지", "소연",
+	"하영", "예지", "다현", "슬기", "나연", "지수", "지은", "은서", "채영", "수민",
+	"예진", "하나", "혜린", "지민", "보민", "소라", "유리", "세나", "미나", "은아",
 }
 
 var firstNeutral = []string{
-	"Jiwon", "Jimin", "Hana", "Yuna", "Mina", "Yuri", "Sora", "Hyun", "Jun", "Eun",
+	"지원", "지민", "하나", "유나", "미나", "유리", "소라", "현", "준", "은",
 }
 
-// Curated surnames (romanized; common family names).
+// Curated surnames (common family names), one syllable each.
 var lastNames = []string{
-	"Kim", "Lee", "Park", "Choi", "Jung", "Kang", "Cho", "Yoon", "Jang", "Lim",
-	"Han", "Oh", "Seo", "Shin", "Kwon", "Hwang", "Ahn", "Song", "Ryu", "Hong",
-	"Yang", "Ko", "Moon", "Baek", "Heo", "Nam", "Jeon", "Bae", "No", "Min",
+	"김", "이", "박", "최", "정", "강", "조", "윤", "장", "임",
+	"한", "오", "서", "신", "권", "황", "안", "송", "류", "홍",
+	"양", "고", "문", "백", "허", "남", "전", "배", "노", "민",
+}
+
+// Less common surnames, for procedural names at higher realism.
+var moreSurnames = []string{
+	"유", "구", "우", "원", "천", "방", "공", "곽", "성", "차", "주", "변", "심", "함", "엄", "염",
+	"표", "진", "석", "여", "추", "도", "소", "하", "지", "채", "위", "설", "마", "길", "연", "선",
+}
+
+type weighted struct {
+	text   string
+	weight int
+}
+
+func pickWeighted(items []weighted, r api.RandLike) string {
+	total := 0
+	for _, it := range items {
+		total += it.weight
+	}
+	n := r.Intn(total)
+	for _, it := range items {
+		if n < it.weight {
+			return it.text
+		}
+		n -= it.weight
+	}
+	return items[len(items)-1].text
+}
+
+// Given-name syllables by position, weighted by how often they appear in
+// modern names.
+var givenSyllables = map[string][2][]weighted{
+	"male": {
+		{{"민", 12}, {"서", 8}, {"도", 7}, {"예", 5}, {"시", 5}, {"하", 6}, {"주", 6}, {"지", 8}, {"준", 6}, {"현", 6},
+			{"건", 4}, {"우", 4}, {"승", 5}, {"유", 4}, {"은", 3}, {"정", 4}, {"성", 5}, {"동", 4}, {"재", 5}, {"태", 4},
+			{"영", 4}, {"상", 3}, {"진", 3}, {"종", 3}, {"병", 2}, {"경", 2}, {"대", 2}, {"광", 2}},
+		{{"준", 14}, {"우", 10}, {"호", 8}, {"민", 7}, {"현", 8}, {"훈", 6}, {"원", 6}, {"진", 5}, {"수", 5}, {"혁", 4},
+			{"석", 4}, {"빈", 4}, {"윤", 4}, {"성", 4}, {"환", 3}, {"재", 3}, {"규", 3}, {"철", 3}, {"영", 3}, {"식", 2},
+			{"결", 2}, {"찬", 3}, {"율", 3}},
+	},
+	"female": {
+		{{"서", 12}, {"지", 12}, {"수", 7}, {"민", 6}, {"하", 8}, {"예", 7}, {"유", 6}, {"채", 5}, {"은", 6}, {"소", 5},
+			{"다", 4}, {"나", 4}, {"혜", 5}, {"미", 4}, {"윤", 4}, {"세", 3}, {"가", 3}, {"연", 3}, {"정", 4}, {"현", 3},
+			{"아", 3}, {"보", 2}, {"선", 2}, {"영", 3}},
+		{{"연", 12}, {"윤", 8}, {"은", 6}, {"아", 8}, {"진", 7}, {"영", 6}, {"희", 6}, {"민", 6}, {"원", 6}, {"서", 5},
+			{"현", 5}, {"지", 5}, {"빈", 4}, {"린", 4}, {"유", 4}, {"경", 3}, {"숙", 3}, {"정", 4}, {"미", 3}, {"나", 4},
+			{"리", 4}, {"인", 3}, {"우", 3}, {"하", 3}},
+	},
+}
+
+// Jamo weights for freely composed (fictional) syllables, by Unicode index.
+var (
+	initialWeights = []int{6, 1, 4, 5, 0, 2, 5, 4, 0, 7, 1, 10, 8, 0, 4, 1, 3, 1, 7}
+	medialWeights  = []int{8, 4, 1, 0, 6, 2, 5, 2, 5, 2, 0, 1, 1, 5, 2, 0, 1, 3, 3, 1, 9}
+	finalWeights   = map[int]int{0: 14, 1: 2, 4: 8, 8: 3, 16: 2, 17: 1, 21: 8}
+)
+
+func pickIndex(weights []int, r api.RandLike) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	n := r.Intn(total)
+	for i, w := range weights {
+		if n < w {
+			return i
+		}
+		n -= w
+	}
+	return len(weights) - 1
+}
+
+// composeSyllable builds a syllable block from weighted jamo.
+func composeSyllable(r api.RandLike) string {
+	b := block{initial: pickIndex(initialWeights, r), medial: pickIndex(medialWeights, r)}
+	finals := make([]int, finalCount)
+	for i, w := range finalWeights {
+		finals[i] = w
+	}
+	b.final = pickIndex(finals, r)
+	// Avoid a bare ㅢ after a consonant too often (it is read as ㅣ).
+	if b.initial != initialIEUNG && medialJamo[b.medial] == "ㅢ" && r.Intn(100) < 70 {
+		b.medial = len(medialJamo) - 1
+	}
+	return b.String()
+}
+
+// Romanization systems (cfg.Romanization).
+const (
+	romanPassport = "passport"          // common passport spellings, the default: Lee Hyunwoo, Park Soojin
+	romanRevised  = "revised"           // Revised Romanization (2000): Yi Hyeonu, Bak Sujin
+	romanMcCune   = "mccune-reischauer" // McCune-Reischauer: Yi Hyŏn-u, Pak Su-jin
+)
+
+var romanAliases = map[string]string{
+	"passport": romanPassport,
+	"revised":  romanRevised, "rr": romanRevised, "revised-romanization": romanRevised,
+	"mccune-reischauer": romanMcCune, "mr": romanMcCune, "mccune": romanMcCune,
+}
+
+// Scripts lists the native script: Hangul.
+func (p koreanProfile) Scripts() []string {
+	return []string{"hangul"}
+}
+
+// Romanizations lists the romanization systems, passport spellings first.
+func (p koreanProfile) Romanizations() []string {
+	return []string{romanPassport, romanRevised, romanMcCune}
 }
 
-// Procedural building blocks (very simplified romanization).
-// Korean romanized given names commonly combine 2 syllables.
-var initials = []string{
-	"g", "k", "n", "d", "t", "r", "m", "b", "p", "s", "j", "ch", "h",
-	"kk", "tt", "pp", "ss", "jj", // stylized double consonants (rarely used here)
-	"", "", "", // allow vowel-start sometimes
+// Passport spellings of given-name syllables that differ from Revised
+// Romanization.
+var passportSyllables = map[string]string{
+	"현": "hyun", "형": "hyung", "혁": "hyuk", "성": "sung", "정": "jung", "영": "young", "경": "kyung",
+	"석": "suk", "선": "sun", "수": "soo", "우": "woo", "주": "joo", "훈": "hoon", "윤": "yoon", "순": "soon",
+	"숙": "sook", "기": "ki", "규": "kyu", "광": "kwang", "근": "keun", "균": "kyun", "철": "chul", "범": "bum",
+	"덕": "duk", "강": "kang", "병": "byung", "명": "myung", "청": "chung", "운": "woon", "건": "gun", "구": "koo",
 }
 
-var vowels = []string{
-	"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe",
-	"u", "wo", "we", "wi", "yu", "eu", "ui", "i",
+// Passport spellings of surnames, weighted by how common each is
+// (Lee/Yi/Rhee, Park/Pak/Bak).
+var passportSurnames = map[string][]weighted{
+	"김": {{"Kim", 1}}, "이": {{"Lee", 90}, {"Yi", 8}, {"Rhee", 2}}, "박": {{"Park", 95}, {"Pak", 3}, {"Bak", 2}},
+	"최": {{"Choi", 90}, {"Choe", 10}}, "정": {{"Jung", 60}, {"Jeong", 35}, {"Chung", 5}}, "강": {{"Kang", 1}},
+	"조": {{"Cho", 80}, {"Jo", 20}}, "윤": {{"Yoon", 70}, {"Yun", 30}}, "장": {{"Jang", 70}, {"Chang", 30}},
+	"임": {{"Lim", 70}, {"Im", 25}, {"Rim", 5}}, "한": {{"Han", 1}}, "오": {{"Oh", 1}}, "서": {{"Seo", 80}, {"Suh", 20}},
+	"신": {{"Shin", 90}, {"Sin", 10}}, "권": {{"Kwon", 1}}, "황": {{"Hwang", 1}}, "안": {{"Ahn", 90}, {"An", 10}},
+	"송": {{"Song", 1}}, "류": {{"Ryu", 50}, {"Yoo", 40}, {"Yu", 10}}, "홍": {{"Hong", 1}}, "양": {{"Yang", 1}},
+	"고": {{"Ko", 60}, {"Go", 40}}, "문": {{"Moon", 85}, {"Mun", 15}}, "백": {{"Baek", 60}, {"Paik", 30}, {"Back", 10}},
+	"허": {{"Heo", 60}, {"Huh", 40}}, "남": {{"Nam", 1}}, "전": {{"Jeon", 60}, {"Chun", 30}, {"Jun", 10}},
+	"배": {{"Bae", 1}}, "노": {{"Noh", 70}, {"No", 20}, {"Roh", 10}}, "민": {{"Min", 1}},
+	"유": {{"Yoo", 60}, {"Yu", 40}}, "구": {{"Koo", 60}, {"Ku", 40}}, "우": {{"Woo", 1}}, "천": {{"Chun", 60}, {"Cheon", 40}},
+	"곽": {{"Kwak", 1}}, "성": {{"Sung", 70}, {"Seong", 30}}, "주": {{"Joo", 60}, {"Ju", 40}}, "변": {{"Byun", 70}, {"Byeon", 30}},
+	"심": {{"Shim", 70}, {"Sim", 30}}, "엄": {{"Eom", 50}, {"Um", 50}}, "염": {{"Yeom", 1}}, "석": {{"Seok", 50}, {"Suk", 50}},
+	"설": {{"Seol", 50}, {"Sul", 50}}, "길": {{"Gil", 50}, {"Kil", 50}}, "선": {{"Sun", 60}, {"Seon", 40}},
 }
 
-var finals = []string{
-	"", "", "", "", // open syllables are common in romanized style names
-	"n", "m", "ng", "k", "t", "l", "r", "s",
+// romanizeGiven spells a Hangul given name.
+func romanizeGiven(hangul, romanization string) string {
+	blocks := decompose(hangul)
+	var b strings.Builder
+	for i, bl := range blocks {
+		switch romanization {
+		case romanMcCune:
+			// Given names are hyphenated in McCune-Reischauer.
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteString(bl.mr(i > 0 && blocks[i-1].voicedFinal()))
+		case romanRevised:
+			b.WriteString(bl.rr())
+		default:
+			if p, ok := passportSyllables[bl.String()]; ok {
+				b.WriteString(p)
+			} else {
+				b.WriteString(bl.rr())
+			}
+		}
+	}
+	return capitalize(b.String())
+}
+
+// romanizeSurname spells a Hangul surname; passport spellings vary between
+// families, so r picks one.
+func romanizeSurname(hangul, romanization string, r api.RandLike) string {
+	if hangul == "이" && romanization != romanPassport {
+		return "Yi" // by convention in every system
+	}
+	if romanization == romanPassport {
+		if variants, ok := passportSurnames[hangul]; ok {
+			return pickWeighted(variants, r)
+		}
+	}
+	return romanizeGiven(hangul, romanization)
+}
+
+func genGivenProcedural(r api.RandLike, gender string, realism int) string {
+	// Typically 2 syllables; sometimes 3 at low realism.
+	n := 2
+	if realism < 40 && r.Intn(100) < 25 {
+		n = 3
+	}
+	if gender != "male" && gender != "female" {
+		gender = "male"
+		if r.Intn(2) == 0 {
+			gender = "female"
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		// Real name syllables in proportion to realism, otherwise freely
+		// composed ones.
+		if n == 2 && api.Chance(r, realism) {
+			b.WriteString(pickWeighted(givenSyllables[gender][i], r))
+		} else {
+			b.WriteString(composeSyllable(r))
+		}
+	}
+	return b.String()
 }
 
 func (p koreanProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	romanization := resolveRomanization(cfg.Romanization)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -95,87 +306,57 @@ func (p koreanProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyllable := func() string {
-		ini := api.PickRand(initials, r)
-		v := api.PickRand(vowels, r)
-		fin := api.PickRand(finals, r)
 
-		// Light cleanup: avoid awkward doubled-double initials too often.
-		if (ini == "kk" || ini == "tt" || ini == "pp" || ini == "ss" || ini == "jj") && r.Intn(100) < 75 {
-			ini = strings.TrimLeft(ini, "kptsj")
-			if ini == "" {
-				ini = "k"
-			}
-		}
-		// Avoid empty + ui too often
-		if ini == "" && v == "ui" && r.Intn(100) < 70 {
-			v = "i"
-		}
-		return ini + v + fin
-	}
-
-	genGivenProcedural := func() string {
-		// Typically 2 syllables; sometimes 3 at low realism.
-		n := 2
-		if realism < 40 && r.Intn(100) < 25 {
-			n = 3
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyllable())
-		}
-		return b.String()
-	}
-
-	first := ""
-	if chooseFromReal() {
+	given := ""
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
-			first = api.PickRand(firstMale, r)
+			given = api.PickRand(firstMale, r)
 		case "female":
-			first = api.PickRand(firstFemale, r)
+			given = api.PickRand(firstFemale, r)
 		default:
 			roll := r.Intn(100)
 			if roll < 60 {
-				first = api.PickRand(firstNeutral, r)
+				given = api.PickRand(firstNeutral, r)
 			} else if roll < 80 {
-				first = api.PickRand(firstMale, r)
+				given = api.PickRand(firstMale, r)
 			} else {
-				first = api.PickRand(firstFemale, r)
+				given = api.PickRand(firstFemale, r)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		given = genGivenProcedural(r, cfg.Gender, realism)
 	}
 
-	last := ""
+	// Korean surnames are one syllable.
+	surname, last := "", ""
 	if cfg.IncludeLast {
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				// Korean surnames are usually one syllable; keep it short.
-				s := genSyllable()
-				// Force shorter-ish surname by trimming to first 2-5 chars
-				if len(s) > 5 {
-					s = s[:5]
-				}
-				last = caser.String(s)
-			}
-		} else {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSyllable())
-			}
+		switch {
+		case api.Chance(r, useRealPct):
+			surname = api.PickRand(lastNames, r)
+		case realism >= 40:
+			surname = api.PickRand(moreSurnames, r)
+		default:
+			surname = composeSyllable(r)
 		}
+		last = romanizeSurname(surname, romanization, r)
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+	res := api.NameResult{First: romanizeGiven(given, romanization), Last: last}
+	if api.NativeScript(p, cfg) != "" {
+		// Surname first, no space: 김민준.
+		res.Native = surname + given
+	}
+	return res, nil
+}
+
+// resolveRomanization turns a cfg.Romanization value into a system,
+// passport spellings by default.
+func resolveRomanization(romanization string) string {
+	if r, ok := romanAliases[strings.ToLower(strings.TrimSpace(romanization))]; ok {
+		return r
+	}
+	return romanPassport
 }
 
 // Profile is the core exported symbol
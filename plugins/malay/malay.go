@@ -1,11 +1,10 @@
 package malay
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type malayProfile struct{}
@@ -23,6 +22,47 @@ func (p malayProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p malayProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Tuan, Puan, Encik and Cik, and Haji, Hajah, Datuk and Datin
+// with the given name.
+func (p malayProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Tuan", Gender: "male", Weight: 30},
+			{Text: "Puan", Gender: "female", Weight: 30},
+			{Text: "Encik", Gender: "male", Weight: 30},
+			{Text: "Cik", Gender: "female", Weight: 20},
+			{Text: "Haji", Gender: "male", Weight: 8, Use: api.UseGiven},
+			{Text: "Hajah", Gender: "female", Weight: 8, Use: api.UseGiven},
+			{Text: "Datuk", Weight: 2, Use: api.UseGiven},
+			{Text: "Datin", Gender: "female", Weight: 1, Use: api.UseGiven},
+			{Text: "Dr.", Weight: 4},
+		},
+	}
+}
+
+// NicknameRules gives the curated short forms (Muhammad -> Mat).
+func (p malayProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated: nicknames,
+	}
+}
+
 // Malaysia naming varies (patronymics common, some family names).
 // We'll generate a given name (First) and optionally a last/family (Last).
 var givenMale = []string{
@@ -66,9 +106,63 @@ var givenEndingsNeutral = []string{"", "", "", "ah", "a", "an", "in"}
 
 var surnameEndings = []string{"", "", "", "bin", "binti", "rahman", "din", "man"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"muhammad": {"Mat", "Mamat"},
+	"abdullah": {"Dollah"},
+	"ahmad":    {"Mat"},
+	"ismail":   {"Mail"},
+	"ibrahim":  {"Baim"},
+	"aminah":   {"Minah"},
+	"siti":     {"Ti"},
+	"nurul":    {"Nunu"},
+	"fatimah":  {"Timah"},
+	"zainab":   {"Nab"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	n := 2
+	if realism < 40 {
+		n = 1 + r.Intn(3) // 1..3
+	} else if r.Intn(100) < 20 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	switch cfg.Gender {
+	case "male":
+		b.WriteString(api.PickRand(givenEndingsMale, r))
+	case "female":
+		b.WriteString(api.PickRand(givenEndingsFemale, r))
+	default:
+		b.WriteString(api.PickRand(givenEndingsNeutral, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 40 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p malayProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -95,51 +189,9 @@ func (p malayProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 2
-		if realism < 40 {
-			n = 1 + r.Intn(3) // 1..3
-		} else if r.Intn(100) < 20 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		switch cfg.Gender {
-		case "male":
-			b.WriteString(api.PickRand(givenEndingsMale, r))
-		case "female":
-			b.WriteString(api.PickRand(givenEndingsFemale, r))
-		default:
-			b.WriteString(api.PickRand(givenEndingsNeutral, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 40 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -156,19 +208,19 @@ func (p malayProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = api.Title(genSurnameProcedural(r, realism))
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile malayProfile
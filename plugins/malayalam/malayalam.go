@@ -0,0 +1,112 @@
+package malayalam
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/indic"
+)
+
+type malayalamProfile struct{}
+
+const PROFILE = "malayalam"
+
+func init() {
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p malayalamProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Malayalam names (romanized, ASCII): curated + shared Indic phonotactics; conventions surname, initials of house and father (K. J. Yesudas), patronymic, caste-neutral; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p malayalamProfile) Inventory() api.Inventory {
+	return region.Inventory()
+}
+
+// Forms gives Sri and Smt., and chettan and chechi after the given name.
+func (p malayalamProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sri", Gender: "male", Weight: 40},
+			{Text: "Smt.", Gender: "female", Weight: 30},
+			{Text: "chettan", Gender: "male", Weight: 10, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "chechi", Gender: "female", Weight: 10, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Dr.", Weight: 8},
+		},
+	}
+}
+
+// NicknameRules gives curated pet names and the endings -u and -an.
+func (p malayalamProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"u", "an"},
+	}
+}
+
+// FormPatronymic lets family trees use the patronymic convention.
+func (p malayalamProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	return region.FormPatronymic(parent, matronymic, gender, locale)
+}
+
+var firstMale = []string{
+	"Anoop", "Arun", "Biju", "Jayan", "Manoj", "Rajeev", "Sajeev", "Sreejith", "Unnikrishnan", "Vineeth",
+	"Prithviraj", "Mohanlal", "Suresh", "Jithin", "Akhil", "Shaji", "Jose", "Thomas", "Abraham", "Anil",
+}
+
+var firstFemale = []string{
+	"Anjali", "Aswathy", "Divya", "Lekshmi", "Meera", "Nithya", "Parvathy", "Remya", "Sreelekha", "Sindhu",
+	"Bindu", "Asha", "Anitha", "Manju", "Reshma", "Neethu", "Gopika", "Mariamma", "Annamma", "Sheeba",
+}
+
+var firstNeutral = []string{
+	"Sunny", "Babu", "Manju", "Kannan", "Unni", "Ammu", "Appu", "Kuttan",
+}
+
+// Family and house names.
+var lastNames = []string{
+	"Nair", "Menon", "Pillai", "Kurup", "Panicker", "Nambiar", "Warrier", "Kaimal", "Varghese", "Kurian",
+	"Mathew", "Chacko", "Joseph", "Thomas", "Puthenpurayil", "Kizhakkedathu", "Madathil", "Vadakkedath", "Cherian", "Ittyerah",
+}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"unnikrishnan": {"Unni"},
+	"sreejith":     {"Sree"},
+	"vineeth":      {"Vini"},
+	"prithviraj":   {"Raju"},
+	"mohanlal":     {"Lalettan"},
+	"abraham":      {"Avarachan"},
+	"thomas":       {"Thampi"},
+	"lekshmi":      {"Lechu"},
+	"parvathy":     {"Paru"},
+	"mariamma":     {"Mary"},
+}
+
+// region abbreviates the house name and the father's name in initials.
+var region = indic.Region{
+	Male: firstMale, Female: firstFemale, Neutral: firstNeutral,
+	Surnames:      lastNames,
+	InitialFamily: true,
+	InitialFather: true,
+
+	// Zh, nj and sr onsets; -eesh and -ith for men, -ja and -ya for women,
+	// house names in -il, -ath and -ery.
+	Onsets: []string{
+		"", "", "zh", "nj", "sr", "th", "sh", "ch",
+		"k", "m", "n", "p", "r", "s", "t", "v", "y", "j", "l", "b", "d", "g",
+	},
+	MaleEndings:    []string{"", "", "", "eesh", "ith", "een", "an", "kumar"},
+	FemaleEndings:  []string{"", "", "", "a", "ja", "ya", "ni", "ima"},
+	NeutralEndings: []string{"", "", "", "u", "an", "i"},
+	SurnameEndings: []string{"", "", "il", "ath", "ery", "kal", "oor"},
+}
+
+func (p malayalamProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return region.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile malayalamProfile
@@ -4,8 +4,7 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/polynesian"
 )
 
 type maoriProfile struct{}
@@ -13,25 +12,65 @@ type maoriProfile struct{}
 const PROFILE = "maori"
 
 func init() {
+	culture.Lang.MustValidate(givenMale, givenFemale, givenNeutral, surnames)
 	api.RegisterProfile(PROFILE, Profile)
 }
 
 func (p maoriProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Maori-inspired names (ASCII): curated + phonotactic procedural fallback; deterministic",
+		"notes": "Māori-inspired names with macrons (-ascii folds them): curated + validated (C)V procedural fallback; deterministic",
 	}
 }
 
-// Maori uses macrons in real orthography; we keep ASCII.
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p maoriProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Matua, Whaea, Koro and Kui with the given name, the English
+// titles, and the knighthoods Ta and Dame.
+func (p maoriProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Matua", Gender: "male", Weight: 20, Use: api.UseGiven},
+			{Text: "Whaea", Gender: "female", Weight: 20, Use: api.UseGiven},
+			{Text: "Koro", Gender: "male", Weight: 10, Use: api.UseGiven},
+			{Text: "Kui", Gender: "female", Weight: 10, Use: api.UseGiven},
+			{Text: "Mr", Gender: "male", Weight: 20},
+			{Text: "Ms", Gender: "female", Weight: 20},
+			{Text: "Ta", Gender: "male", Weight: 1, Use: api.UseGiven},
+			{Text: "Dame", Gender: "female", Weight: 1, Use: api.UseGiven},
+			{Text: "Dr", Weight: 3},
+		},
+	}
+}
+
+// NicknameRules clips longer names after their first vowel; there are no
+// curated nicknames.
+func (p maoriProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{OpenStem: true}
+}
+
+// Names are written with macrons; -ascii folds them.
 var givenMale = []string{
-	"Wiremu", "Hemi", "Rangi", "Tama", "Hone", "Rawiri", "Tane", "Kauri", "Manu", "Aroha",
-	"Ngata", "Kahu", "Koro", "Matiu", "Hori", "Timi", "Pita", "TeRangi", "Kingi", "Hoani",
+	"Wiremu", "Hēmi", "Rangi", "Tama", "Hōne", "Rāwiri", "Tāne", "Kauri", "Manu", "Aroha",
+	"Ngata", "Kahu", "Koro", "Mātiu", "Hōri", "Timi", "Pita", "Te Rangi", "Kīngi", "Hoani",
 }
 
 var givenFemale = []string{
 	"Aroha", "Anahera", "Mere", "Moana", "Hine", "Ria", "Kiri", "Rangi", "Wai", "Maia",
-	"Marama", "Rere", "Ata", "Hera", "Mereana", "TeAroha", "Tia", "Kahurangi", "Manawa", "Hinemoa",
+	"Marama", "Rere", "Ata", "Hera", "Mereana", "Te Aroha", "Tia", "Kahurangi", "Manawa", "Hinemoa",
 }
 
 var givenNeutral = []string{
@@ -39,7 +78,7 @@ var givenNeutral = []string{
 }
 
 var surnames = []string{
-	"Ngata", "TeRangi", "TeAroha", "TeKahu", "TeWai", "Tame", "Ranginui", "Tukiri", "Kahukura", "Manawa",
+	"Ngata", "Te Rangi", "Te Aroha", "Te Kahu", "Te Wai", "Tame", "Ranginui", "Tukiri", "Kahukura", "Manawa",
 }
 
 // Maori phonotactics are very strict: (C)V with limited consonants; "ng", "wh" common.
@@ -61,9 +100,49 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "a", "e", "i", "o", "u"}
 var surnameEndings = []string{"", "", "", "nui", "rangi", "waka", "manawa"}
 
+// culture validates the names and marks procedural ones.
+var culture = polynesian.Culture{Lang: polynesian.Maori}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// Maori names often 2-4 syllables.
+	n := 3
+	if realism < 40 {
+		n = 2 + r.Intn(3) // 2..4
+	} else if r.Intn(100) < 25 {
+		n = 2 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 40 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 3
+	if realism < 40 {
+		n = 2 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 45 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p maoriProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	marks := polynesian.MarkRand(cfg)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -90,47 +169,10 @@ func (p maoriProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		// Maori names often 2-4 syllables.
-		n := 3
-		if realism < 40 {
-			n = 2 + r.Intn(3) // 2..4
-		} else if r.Intn(100) < 25 {
-			n = 2 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 40 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 3
-		if realism < 40 {
-			n = 2 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 45 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
+	var err error
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -140,19 +182,25 @@ func (p maoriProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			first = api.PickRand(givenNeutral, r)
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first, err = culture.Procedural(marks, func() string { return genGivenProcedural(r, realism) })
+		if err != nil {
+			return api.NameResult{}, err
+		}
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last, err = culture.Procedural(marks, func() string { return genSurnameProcedural(r, realism) })
+			if err != nil {
+				return api.NameResult{}, err
+			}
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile maoriProfile
@@ -0,0 +1,113 @@
+package marathi
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/indic"
+)
+
+type marathiProfile struct{}
+
+const PROFILE = "marathi"
+
+func init() {
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p marathiProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Marathi names (romanized, ASCII): given + father's name + surname (Sachin Ramesh Tendulkar), curated + shared Indic phonotactics; conventions surname, initials, patronymic, caste-neutral; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p marathiProfile) Inventory() api.Inventory {
+	return region.Inventory()
+}
+
+// Forms gives Shri and Shrimati, and -rao and -tai joined to the given
+// name.
+func (p marathiProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Shri", Gender: "male", Weight: 40},
+			{Text: "Shrimati", Gender: "female", Weight: 30},
+			{Text: "rao", Gender: "male", Weight: 10, Use: api.UseGiven, After: true},
+			{Text: "tai", Gender: "female", Weight: 10, Use: api.UseGiven, After: true},
+			{Text: "Dr.", Weight: 8},
+		},
+	}
+}
+
+// NicknameRules gives curated pet names and the endings -ya and -u.
+func (p marathiProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"ya", "u"},
+	}
+}
+
+// FormPatronymic lets family trees use the patronymic convention.
+func (p marathiProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	return region.FormPatronymic(parent, matronymic, gender, locale)
+}
+
+var firstMale = []string{
+	"Sachin", "Ramesh", "Ganesh", "Sunil", "Vinayak", "Prashant", "Ajay", "Mahesh", "Shivaji", "Sanjay",
+	"Tushar", "Nilesh", "Sandeep", "Mangesh", "Vishal", "Amol", "Rahul", "Prakash", "Sameer", "Yogesh",
+}
+
+var firstFemale = []string{
+	"Sneha", "Madhuri", "Ashwini", "Pallavi", "Smita", "Vaishali", "Shubhangi", "Manasi", "Prajakta", "Rutuja",
+	"Sayali", "Gauri", "Swati", "Sonali", "Mrunal", "Aarti", "Deepali", "Ketaki", "Shraddha", "Anuja",
+}
+
+var firstNeutral = []string{
+	"Sai", "Kiran", "Shubham", "Aditi", "Yash", "Gauri", "Ravi", "Mohan",
+}
+
+var lastNames = []string{
+	"Patil", "Deshmukh", "Kulkarni", "Joshi", "Pawar", "Jadhav", "Shinde", "Gaikwad", "Deshpande", "Tendulkar",
+	"Gokhale", "Apte", "Bhosale", "Chavan", "Kale", "More", "Salunkhe", "Sawant", "Thakre", "Wagh",
+}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"sachin":    {"Sachya"},
+	"ganesh":    {"Ganya"},
+	"mahesh":    {"Mahya"},
+	"vinayak":   {"Vinu"},
+	"prashant":  {"Pashya"},
+	"shubhangi": {"Shubhu"},
+	"madhuri":   {"Madhu"},
+	"ashwini":   {"Ashu"},
+	"pallavi":   {"Pallu"},
+	"rutuja":    {"Rutu"},
+}
+
+// region writes the father's given name between given name and surname.
+var region = indic.Region{
+	Male: firstMale, Female: firstFemale, Neutral: firstNeutral,
+	Surnames:      lastNames,
+	FatherMiddle:  true,
+	InitialFather: true,
+
+	// Jh and dny onsets; -ak and -ant for men, -ali and -ini for women,
+	// surnames in -kar, -pande and -ale.
+	Onsets: []string{
+		"", "",
+		"b", "bh", "d", "dh", "g", "gh", "k", "kh", "m", "n", "p", "ph",
+		"r", "s", "sh", "t", "th", "v", "y", "ch", "j", "jh", "h", "l", "dny",
+	},
+	MaleEndings:    []string{"", "", "", "ant", "esh", "ak", "ar", "it"},
+	FemaleEndings:  []string{"", "", "", "ali", "ini", "a", "i", "ika"},
+	NeutralEndings: []string{"", "", "", "i", "a"},
+	SurnameEndings: []string{"", "", "kar", "pande", "karni", "ale", "e"},
+}
+
+func (p marathiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return region.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile marathiProfile
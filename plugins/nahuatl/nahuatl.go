@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type nahuatlProfile struct{}
@@ -23,6 +21,43 @@ func (p nahuatlProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p nahuatlProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the reverential -tzin, which replaces -tl and -tli, and the
+// titles that follow the given name.
+func (p nahuatlProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "tzin", Weight: 50, Use: api.UseGiven, After: true, Replaces: []string{"tli", "tl"}},
+			{Text: "Tlatoani", Gender: "male", Weight: 5, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Cihuatlatoani", Gender: "female", Weight: 3, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Tlamatini", Weight: 4, Use: api.UseGiven, After: true, Join: " "},
+		},
+	}
+}
+
+// NicknameRules adds the reverential -tzin and the diminutive -ton to
+// longer names; there are no curated nicknames.
+func (p nahuatlProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Suffixes: []string{"tzin", "ton"},
+	}
+}
+
 // Curated Nahuatl-inspired / Nahuatl-origin names in common Latin transliteration.
 // (Not exhaustive; expand anytime.)
 var firstMale = []string{
@@ -65,9 +100,70 @@ var codas = []string{"", "", "", "l", "n", "m", "t", "k", "tl", "tz"}
 var givenEndings = []string{"", "", "", "tl", "tli", "tzin", "yotl", "coatl", "tecuhtli"}
 var surnameEndings = []string{"", "", "", "tzin", "yotl", "tl", "tli", "co", "pan", "tlan"}
 
+func genSyl(r api.RandLike) string {
+	// Mostly onset+vowel(+optional coda)
+	s := api.PickRand(onsets, r) + api.PickRand(vowels, r)
+	if r.Intn(100) < 35 {
+		s += api.PickRand(codas, r)
+	}
+	return s
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// 2–4 syllables; low realism allows 1–4
+	numSyl := 2 + r.Intn(3) // 2..4
+	if realism < 35 {
+		numSyl = 1 + r.Intn(4) // 1..4
+	}
+
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Add a characteristic ending more often at higher realism
+	thr := 20
+	if realism >= 80 {
+		thr = 55
+	} else if realism >= 60 {
+		thr = 40
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(givenEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	// 2–3 syllables
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	thr := 25
+	if realism >= 80 {
+		thr = 55
+	} else if realism >= 60 {
+		thr = 40
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
 func (p nahuatlProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -96,73 +192,10 @@ func (p nahuatlProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Mostly onset+vowel(+optional coda)
-		s := api.PickRand(onsets, r) + api.PickRand(vowels, r)
-		if r.Intn(100) < 35 {
-			s += api.PickRand(codas, r)
-		}
-		return s
-	}
-
-	genGivenProcedural := func() string {
-		// 2–4 syllables; low realism allows 1–4
-		numSyl := 2 + r.Intn(3) // 2..4
-		if realism < 35 {
-			numSyl = 1 + r.Intn(4) // 1..4
-		}
-
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Add a characteristic ending more often at higher realism
-		thr := 20
-		if realism >= 80 {
-			thr = 55
-		} else if realism >= 60 {
-			thr = 40
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(givenEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		// 2–3 syllables
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		thr := 25
-		if realism >= 80 {
-			thr = 55
-		} else if realism >= 60 {
-			thr = 40
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
 
 	// ---- First name selection ----
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -179,30 +212,30 @@ func (p nahuatlProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
 	// ---- Last name selection ----
 	last := ""
 	if cfg.IncludeLast {
 		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		} else {
 			// If Family override is something else, still produce Nahuatl-ish surname for now.
-			if chooseFromReal() {
+			if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
+	first = api.Title(first)
+	last = api.Title(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
@@ -1,11 +1,10 @@
 package nordic
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type nordicProfile struct{}
@@ -23,6 +22,44 @@ func (p nordicProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p nordicProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Herr, Fru and Froken.
+func (p nordicProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Herr", Gender: "male", Weight: 30},
+			{Text: "Fru", Gender: "female", Weight: 30},
+			{Text: "Froken", Gender: "female", Weight: 4},
+			{Text: "Dr.", Weight: 5},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms and the endings -e for men and
+// -a for women.
+func (p nordicProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:        nicknames,
+		MaleSuffixes:   []string{"e"},
+		FemaleSuffixes: []string{"a"},
+	}
+}
+
 // Curated Scandinavian given names (ASCII only; expand anytime).
 var firstMale = []string{
 	"Erik", "Karl", "Lars", "Sven", "Bjorn", "Leif", "Nils", "Oskar", "Otto", "Felix",
@@ -65,9 +102,96 @@ var givenEndingsNeutral = []string{"", "", "", "en", "in", "e"}
 
 var surnameEndings = []string{"", "", "", "son", "sen", "berg", "strom", "lund", "holm", "gaard", "vik"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"karl":      {"Kalle"},
+	"lars":      {"Lasse"},
+	"nils":      {"Nisse"},
+	"anders":    {"Ante"},
+	"magnus":    {"Mange"},
+	"henrik":    {"Henke"},
+	"kristian":  {"Krille"},
+	"torbjorn":  {"Tobbe"},
+	"johan":     {"Jocke", "Jonte"},
+	"hans":      {"Hasse"},
+	"mats":      {"Matte"},
+	"kristin":   {"Stina", "Kicki"},
+	"margareta": {"Maggan", "Greta"},
+	"elisabet":  {"Lisa", "Elsa"},
+	"katarina":  {"Kajsa", "Kattis"},
+	"kristina":  {"Stina", "Kicki"},
+	"matilda":   {"Tilda"},
+	"emilia":    {"Mia"},
+	"johanna":   {"Hanna"},
+	"astrid":    {"Assa"},
+}
+
+func genSyl(r api.RandLike) string {
+	// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel.
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Ending by gender (light touch)
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Surname endings more likely at higher realism
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
 func (p nordicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -96,116 +220,66 @@ func (p nordicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel.
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Ending by gender (light touch)
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Surname endings more likely at higher realism
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
 
 	// ---- First name selection ----
-	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(firstMale, r)
-		case "female":
-			first = api.PickRand(firstFemale, r)
-		default:
-			roll := r.Intn(100)
-			if roll < 60 {
-				first = api.PickRand(firstNeutral, r)
-			} else if roll < 80 {
-				first = api.PickRand(firstMale, r)
-			} else {
-				first = api.PickRand(firstFemale, r)
-			}
-		}
-	} else {
-		first = caser.String(genGivenProcedural())
-	}
+	locale := resolveLocale(cfg.Family)
+	first := pickGiven(r, cfg, cfg.Gender, locale, useRealPct, realism)
 
 	// ---- Last name selection ----
 	last := ""
+	var parts *api.NameParts
 	if cfg.IncludeLast {
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
+		switch convention := resolveConvention(cfg.Convention, locale); convention {
+		case api.ConventionPatronymic, api.ConventionMatronymic:
+			// Draw the parent from the same profile and build the surname
+			// from their given name.
+			parts = &api.NameParts{Locale: locale, Convention: convention}
+			if convention == api.ConventionMatronymic {
+				parts.Mother = pickGiven(r, cfg, "female", locale, useRealPct, realism)
+				last = p.FormPatronymic(parts.Mother, true, cfg.Gender, locale)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				parts.Father = pickGiven(r, cfg, "male", locale, useRealPct, realism)
+				last = p.FormPatronymic(parts.Father, false, cfg.Gender, locale)
 			}
-		} else {
-			// If Family override is something else, still produce Nordic-ish surname for now.
-			if chooseFromReal() {
+		default:
+			if locale == localeIcelandic {
+				last = api.PickRand(icelandicSurnames, r)
+			} else if api.Chance(r, useRealPct) {
 				last = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				last = api.Title(genSurnameProcedural(r, realism))
 			}
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+	first = api.Title(first)
+	return api.NameResult{First: first, Last: last, Parts: parts}, nil
+}
+
+// pickGiven draws a given name of gender: curated (Icelandic names for the
+// Icelandic sub-locale) with probability useRealPct, procedural otherwise.
+func pickGiven(r api.RandLike, cfg api.ProfileConfig, gender, locale string, useRealPct, realism int) string {
+	male, female := firstMale, firstFemale
+	if locale == localeIcelandic {
+		male, female = icelandicMale, icelandicFemale
+	}
+	if !api.Chance(r, useRealPct) {
+		cfg.Gender = gender
+		return api.Title(genGivenProcedural(r, cfg, realism))
+	}
+	switch gender {
+	case "male":
+		return api.PickRand(male, r)
+	case "female":
+		return api.PickRand(female, r)
+	}
+	roll := r.Intn(100)
+	if roll < 60 {
+		return api.PickRand(firstNeutral, r)
+	} else if roll < 80 {
+		return api.PickRand(male, r)
+	}
+	return api.PickRand(female, r)
 }
 
 // Profile is the core exported symbol
@@ -0,0 +1,177 @@
+package nordic
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Sub-locales, selected with cfg.Family. Icelandic defaults to patronymics;
+// the others keep fixed surnames unless cfg.Convention asks for them.
+const (
+	localeIcelandic = "is"
+	localeSwedish   = "sv"
+	localeNorwegian = "no"
+	localeDanish    = "da"
+)
+
+var localeAliases = map[string]string{
+	"is": localeIcelandic, "icelandic": localeIcelandic, "iceland": localeIcelandic,
+	"sv": localeSwedish, "se": localeSwedish, "swedish": localeSwedish, "sweden": localeSwedish,
+	"no": localeNorwegian, "nb": localeNorwegian, "norwegian": localeNorwegian, "norway": localeNorwegian,
+	"da": localeDanish, "dk": localeDanish, "danish": localeDanish, "denmark": localeDanish,
+}
+
+// Icelandic names keep their letters (ð, þ, accents): patronymics are
+// formed from the exact genitive.
+var icelandicMale = []string{
+	"Jón", "Sigurður", "Guðmundur", "Gunnar", "Ólafur", "Einar", "Kristján", "Magnús", "Stefán", "Jóhann",
+	"Björn", "Árni", "Helgi", "Páll", "Ragnar", "Þórður", "Haraldur", "Bjarni", "Halldór", "Egill",
+	"Hjalti", "Ari", "Finnur", "Davíð", "Pétur", "Gísli", "Hannes", "Snorri", "Sturla", "Þór",
+}
+
+var icelandicFemale = []string{
+	"Guðrún", "Anna", "Kristín", "Sigríður", "Margrét", "Helga", "Sigrún", "Ingibjörg", "Jóhanna", "María",
+	"Katrín", "Ragnheiður", "Ásta", "Elín", "Hildur", "Þórunn", "Birna", "Auður", "Björk", "Hrafnhildur",
+	"Steinunn", "Halla", "Sólveig", "Edda", "Vigdís",
+}
+
+// icelandicSurnames are the few inherited family names, used with
+// Convention "surname".
+var icelandicSurnames = []string{
+	"Blöndal", "Briem", "Thors", "Hafstein", "Thoroddsen", "Kvaran", "Hjaltalín", "Bergmann", "Zoëga", "Thorlacius",
+}
+
+// icelandicGenitives are irregular genitives.
+var icelandicGenitives = map[string]string{
+	"pétur": "Péturs", "þórður": "Þórðar", "ingibjörg": "Ingibjargar", "björk": "Bjarkar",
+}
+
+// resolveLocale turns a cfg.Family value into a sub-locale ("" if none).
+func resolveLocale(family string) string {
+	return localeAliases[strings.ToLower(strings.TrimSpace(family))]
+}
+
+// resolveConvention picks the naming convention: cfg.Convention when set,
+// otherwise patronymics in Iceland and fixed surnames elsewhere.
+func resolveConvention(convention, locale string) string {
+	switch c := strings.ToLower(strings.TrimSpace(convention)); c {
+	case api.ConventionPatronymic, api.ConventionMatronymic, api.ConventionSurname:
+		return c
+	}
+	if locale == localeIcelandic {
+		return api.ConventionPatronymic
+	}
+	return api.ConventionSurname
+}
+
+// FormPatronymic builds a patronymic or matronymic from a parent's given
+// name: Icelandic Jón -> Jónsson/Jónsdóttir (Jónsbur for neutral), Helgi ->
+// Helgason, Anna -> Önnudóttir; Swedish Johan -> Johansson/Johansdotter;
+// Norwegian and Danish Hans -> Hansen/Hansdatter, Ole -> Olsen. Without a
+// locale the Swedish forms are used.
+func (p nordicProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	if parent == "" {
+		return ""
+	}
+	if loc := resolveLocale(locale); loc != "" {
+		locale = loc
+	}
+
+	switch locale {
+	case localeIcelandic:
+		gen := icelandicGenitive(parent, matronymic)
+		switch gender {
+		case "female":
+			return gen + "dóttir"
+		case "male":
+			return gen + "son"
+		}
+		return gen + "bur"
+
+	case localeNorwegian, localeDanish:
+		stem := parent
+		if strings.HasSuffix(stem, "e") {
+			stem = strings.TrimSuffix(stem, "e") // Ole -> Olsen
+		}
+		if !strings.HasSuffix(stem, "s") {
+			stem += "s"
+		}
+		if gender == "female" {
+			return stem + "datter"
+		}
+		return stem + "en"
+	}
+
+	gen := parent
+	if !strings.HasSuffix(gen, "s") {
+		gen += "s"
+	}
+	if gender == "female" {
+		return gen + "dotter"
+	}
+	return gen + "son"
+}
+
+// icelandicGenitive returns the genitive of an Icelandic given name:
+// masculine -ur -> -s (Sigurður -> Sigurðs), -ll -> -ls (Páll -> Páls),
+// weak -i/-a -> -a/-u (Helgi -> Helga, Sturla -> Sturlu), otherwise +s;
+// feminine -ur -> -ar (Sigríður -> Sigríðar), weak -a -> -u with a -> ö in
+// the stem (Anna -> Önnu), otherwise +ar (Guðrún -> Guðrúnar).
+func icelandicGenitive(name string, feminine bool) string {
+	if g, ok := icelandicGenitives[strings.ToLower(name)]; ok {
+		return g
+	}
+	rs := []rune(name)
+	n := len(rs)
+	if n < 2 {
+		return name + "s"
+	}
+	stem := string(rs[:n-1])
+	stem2 := string(rs[:n-2])
+
+	if feminine {
+		switch {
+		case strings.HasSuffix(name, "ur"):
+			return stem2 + "ar"
+		case strings.HasSuffix(name, "a"):
+			return uUmlaut(stem) + "u"
+		case strings.HasSuffix(name, "i"), strings.HasSuffix(name, "y"):
+			return stem + "ar"
+		}
+		return name + "ar"
+	}
+
+	switch {
+	case strings.HasSuffix(name, "ur"):
+		return stem2 + "s"
+	case strings.HasSuffix(name, "ll"):
+		return stem + "s"
+	case strings.HasSuffix(name, "i"):
+		return stem + "a"
+	case strings.HasSuffix(name, "a"):
+		return stem + "u"
+	case strings.HasSuffix(name, "s"):
+		return name
+	}
+	return name + "s"
+}
+
+// uUmlaut turns the last "a" of a stem into "ö" (Ann -> Önn, Jóhann ->
+// Jóhönn), as a following -u does in Icelandic.
+func uUmlaut(stem string) string {
+	rs := []rune(stem)
+	for i := len(rs) - 1; i >= 0; i-- {
+		switch rs[i] {
+		case 'a':
+			rs[i] = 'ö'
+			return string(rs)
+		case 'A':
+			rs[i] = 'Ö'
+			return string(rs)
+		case 'e', 'i', 'o', 'u', 'y', 'á', 'é', 'í', 'ó', 'ú', 'ý', 'æ', 'ö':
+			return stem
+		}
+	}
+	return stem
+}
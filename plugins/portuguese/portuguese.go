@@ -1,9 +1,10 @@
 package portuguese
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type portugueseProfile struct{}
@@ -17,7 +18,55 @@ func init() {
 func (p portugueseProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Portuguese (Portugal/Brazil) names, ASCII",
+		"notes": "Portuguese names, ASCII: maternal + paternal surnames (conventions double, joined, surname), compound given names; families brazil (default), portugal",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p portugueseProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    slices.Concat(firstMale, compoundMale[localeBrazil], compoundMale[localePortugal]),
+		FirstFemale:  slices.Concat(firstFemale, compoundFemale[localeBrazil], compoundFemale[localePortugal]),
+		FirstNeutral: firstNeutral,
+		Last:         slices.Concat(lastNames, particleSurnames),
+		Onsets:       onsets,
+		Nuclei:       vowels,
+		Codas:        codas,
+	}
+}
+
+// Forms gives the Portuguese titles and the family suffixes Filho, Junior,
+// Neto and Sobrinho.
+func (p portugueseProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sr.", Gender: "male", Weight: 40},
+			{Text: "Sra.", Gender: "female", Weight: 40},
+			{Text: "Dom", Gender: "male", Weight: 3, Use: api.UseGiven},
+			{Text: "Dona", Gender: "female", Weight: 12, Use: api.UseGiven},
+			{Text: "Dr.", Gender: "male", Weight: 8},
+			{Text: "Dra.", Gender: "female", Weight: 8},
+			{Text: "Prof.", Gender: "male", Weight: 3},
+			{Text: "Profa.", Gender: "female", Weight: 3},
+			{Text: "Eng.", Weight: 2},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "Filho", Gender: "male", Weight: 50},
+			{Text: "Junior", Gender: "male", Weight: 30},
+			{Text: "Neto", Gender: "male", Weight: 25},
+			{Text: "Sobrinho", Gender: "male", Weight: 5},
+			{Text: "Filha", Gender: "female", Weight: 5},
+			{Text: "Neta", Gender: "female", Weight: 5},
+		},
+		SuffixPct: 10,
+	}
+}
+
+// NicknameRules gives curated short forms and the ending -inho/-inha.
+func (p portugueseProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"inho"},
 	}
 }
 
@@ -41,6 +90,54 @@ var lastNames = []string{
 	"Martins", "Araujo", "Rocha",
 }
 
+// Surnames with particles ("da", "dos", "de", "das", "do").
+var particleSurnames = []string{
+	"da Silva", "dos Santos", "de Oliveira", "da Costa", "de Souza", "das Neves", "do Nascimento", "da Conceicao",
+	"dos Reis", "de Almeida",
+}
+
+// Sub-locales, selected with cfg.Family.
+const (
+	localeBrazil   = "br"
+	localePortugal = "pt"
+)
+
+var localeAliases = map[string]string{
+	"br": localeBrazil, "brazil": localeBrazil, "brazilian": localeBrazil, "pt-br": localeBrazil,
+	"pt": localePortugal, "portugal": localePortugal, "european": localePortugal, "pt-pt": localePortugal,
+}
+
+// Compound given names per sub-locale, used in full.
+var compoundMale = map[string][]string{
+	localeBrazil: {
+		"Joao Pedro", "Pedro Henrique", "Joao Vitor", "Luiz Felipe", "Carlos Eduardo", "Joao Gabriel", "Luiz Gustavo",
+		"Paulo Roberto", "Jose Carlos", "Luiz Fernando",
+	},
+	localePortugal: {
+		"Joao Miguel", "Jose Maria", "Pedro Miguel", "Rui Pedro", "Nuno Miguel", "Joao Pedro", "Luis Filipe",
+		"Antonio Jose", "Carlos Manuel", "Jose Manuel",
+	},
+}
+
+var compoundFemale = map[string][]string{
+	localeBrazil: {
+		"Maria Eduarda", "Ana Clara", "Maria Clara", "Ana Julia", "Maria Luiza", "Ana Beatriz", "Maria Fernanda",
+		"Ana Luiza", "Maria Vitoria", "Ana Carolina",
+	},
+	localePortugal: {
+		"Maria Joao", "Ana Rita", "Maria Ines", "Ana Sofia", "Maria Teresa", "Ana Catarina", "Maria Jose",
+		"Ana Margarida", "Maria Manuel", "Rita Isabel",
+	},
+}
+
+// European spellings of the Brazilian forms in the curated lists.
+var portugalSpelling = strings.NewReplacer("Souza", "Sousa", "Luiz", "Luis")
+
+// Per sub-locale defaults: chance of a compound given name and of a
+// surname with a particle.
+var compoundPct = map[string]int{localeBrazil: 35, localePortugal: 25}
+var particlePct = map[string]int{localeBrazil: 15, localePortugal: 25}
+
 var onsets = []string{
 	"b", "c", "d", "f", "g", "l", "m", "n", "p", "r", "s", "t", "v",
 	"br", "cr", "tr", "pr", "cl",
@@ -55,18 +152,50 @@ var codas = []string{
 	"", "", "", "s", "r", "l", "m", "n",
 }
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"joao":      {"Joaozinho"},
+	"francisco": {"Chico", "Xico"},
+	"jose":      {"Ze", "Zeca"},
+	"antonio":   {"Toninho", "Tonho"},
+	"eduardo":   {"Dudu", "Edu"},
+	"carlos":    {"Carlinhos", "Cacau"},
+	"ricardo":   {"Rica"},
+	"gustavo":   {"Guga", "Gus"},
+	"fernando":  {"Nando"},
+	"rafael":    {"Rafa"},
+	"pedro":     {"Pedrinho", "Pepe"},
+	"luis":      {"Lulu"},
+	"daniel":    {"Dani"},
+	"maria":     {"Mariazinha", "Mia"},
+	"ana":       {"Aninha"},
+	"fernanda":  {"Nanda", "Fe"},
+	"juliana":   {"Ju", "Juju"},
+	"beatriz":   {"Bia"},
+	"patricia":  {"Pati"},
+	"camila":    {"Cami", "Mila"},
+	"luciana":   {"Lu"},
+	"larissa":   {"Lari"},
+
+	"maria eduarda":  {"Duda"},
+	"carlos eduardo": {"Cadu"},
+	"luiz felipe":    {"Lipe"},
+	"joao pedro":     {"JP"},
+	"maria joao":     {"Majo"},
+}
+
+func gen(r api.RandLike) string {
+	return api.PickRand(onsets, r) +
+		api.PickRand(vowels, r) +
+		api.PickRand(codas, r)
+}
+
 func (p portugueseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	locale := resolveLocale(cfg.Family)
 
 	useReal := r.Intn(100) < cfg.Realism+10
 
-	gen := func() string {
-		return api.PickRand(onsets, r) +
-			api.PickRand(vowels, r) +
-			api.PickRand(codas, r)
-	}
-
 	first := ""
 	if useReal {
 		switch cfg.Gender {
@@ -77,20 +206,87 @@ func (p portugueseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, erro
 		default:
 			first = api.PickRand(firstNeutral, r)
 		}
+		first = compoundGiven(r, cfg, locale, first)
 	} else {
-		first = caser.String(gen() + gen())
+		first = api.Title(gen(r) + gen(r))
 	}
 
+	// Surnames go maternal then paternal; the paternal one comes last and is
+	// the one passed on. "joined" puts "e" between them, "surname" keeps only
+	// the father's.
 	last := ""
+	var parts *api.NameParts
 	if cfg.IncludeLast {
-		if useReal {
-			last = api.PickRand(lastNames, r)
-		} else {
-			last = caser.String(gen() + gen())
+		convention := resolveConvention(cfg.Convention)
+		pick := func() string {
+			if !useReal {
+				return api.Title(gen(r) + gen(r))
+			}
+			s := api.PickRand(lastNames, r)
+			if api.Chance(r, particlePct[locale]) {
+				s = api.PickRand(particleSurnames, r)
+			}
+			if locale == localePortugal {
+				s = portugalSpelling.Replace(s)
+			}
+			return s
+		}
+
+		parts = &api.NameParts{Locale: locale, Convention: convention, GivenNames: api.GivenComponents(first), Paternal: pick()}
+		parts.Surnames = []string{parts.Paternal}
+		last = parts.Paternal
+		if convention != api.ConventionSurname {
+			parts.Maternal = pick()
+			for i := 0; i < 3 && parts.Maternal == parts.Paternal; i++ {
+				parts.Maternal = pick()
+			}
+			parts.Surnames = []string{parts.Maternal, parts.Paternal}
+			sep := " "
+			if convention == api.ConventionJoined {
+				sep = " e "
+			}
+			last = parts.Maternal + sep + parts.Paternal
 		}
 	}
 
-	return api.NameResult{First: first, Last: last}, nil
+	return api.NameResult{First: first, Last: last, Parts: parts}, nil
+}
+
+// resolveLocale turns a cfg.Family value into a sub-locale, Brazil by default.
+func resolveLocale(family string) string {
+	if loc, ok := localeAliases[strings.ToLower(strings.TrimSpace(family))]; ok {
+		return loc
+	}
+	return localeBrazil
+}
+
+// resolveConvention returns cfg.Convention when it applies here, otherwise
+// double surnames.
+func resolveConvention(convention string) string {
+	switch c := strings.ToLower(strings.TrimSpace(convention)); c {
+	case api.ConventionSurname, api.ConventionJoined:
+		return c
+	}
+	return api.ConventionDouble
+}
+
+// compoundGiven sometimes replaces a curated given name with a compound one
+// of the sub-locale (cfg.Compound percent, or the sub-locale's default).
+func compoundGiven(r api.RandLike, cfg api.ProfileConfig, locale, first string) string {
+	pct := cfg.Compound
+	if pct == 0 {
+		pct = compoundPct[locale]
+	}
+	if pct < 0 || !api.Chance(r, pct) {
+		return first
+	}
+	switch cfg.Gender {
+	case "male":
+		return api.PickRand(compoundMale[locale], r)
+	case "female":
+		return api.PickRand(compoundFemale[locale], r)
+	}
+	return first
 }
 
 var Profile portugueseProfile
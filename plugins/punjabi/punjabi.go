@@ -0,0 +1,118 @@
+package punjabi
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/indic"
+)
+
+type punjabiProfile struct{}
+
+const PROFILE = "punjabi"
+
+func init() {
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p punjabiProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Punjabi names (romanized, ASCII): Singh for men and Kaur for women, often with a clan name (Harpreet Kaur Sandhu); curated + shared Indic phonotactics; conventions surname, initials, patronymic, caste-neutral; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p punjabiProfile) Inventory() api.Inventory {
+	return region.Inventory()
+}
+
+// Forms gives Sardar, Sardarni and Bibi, and ji after the name.
+func (p punjabiProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sardar", Gender: "male", Weight: 30},
+			{Text: "Sardarni", Gender: "female", Weight: 20},
+			{Text: "Bibi", Gender: "female", Weight: 10},
+			{Text: "ji", Weight: 20, After: true, Join: " "},
+			{Text: "Dr.", Weight: 8},
+		},
+	}
+}
+
+// NicknameRules gives curated pet names and the endings -i and -a.
+func (p punjabiProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"i", "a"},
+	}
+}
+
+// FormPatronymic lets family trees use the patronymic convention.
+func (p punjabiProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	return region.FormPatronymic(parent, matronymic, gender, locale)
+}
+
+// Many Punjabi given names are unisex; Singh or Kaur marks the gender.
+var firstMale = []string{
+	"Gurpreet", "Harpreet", "Manpreet", "Jaspreet", "Harjeet", "Gurdeep", "Jagdeep", "Sukhwinder", "Balwinder", "Kuldeep",
+	"Amarjit", "Ranjit", "Daljit", "Hardeep", "Paramjit", "Gurinder", "Navdeep", "Jaswinder", "Tejinder", "Mandeep",
+}
+
+var firstFemale = []string{
+	"Harpreet", "Gurpreet", "Manpreet", "Simran", "Navneet", "Jasleen", "Harleen", "Amrit", "Kulwinder", "Rajinder",
+	"Baljit", "Gurleen", "Parminder", "Sukhpreet", "Jaspreet", "Rupinder", "Mandeep", "Kiranjeet", "Navjot", "Sandeep",
+}
+
+var firstNeutral = []string{
+	"Harpreet", "Gurpreet", "Manpreet", "Jaspreet", "Mandeep", "Navjot", "Amrit", "Sandeep",
+}
+
+// Clan (got) names, written after Singh or Kaur.
+var lastNames = []string{
+	"Gill", "Sandhu", "Sidhu", "Dhillon", "Grewal", "Brar", "Virk", "Bajwa", "Cheema", "Randhawa",
+	"Mann", "Aulakh", "Bains", "Chahal", "Dhaliwal", "Garcha", "Johal", "Sekhon", "Toor", "Sangha",
+}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"gurpreet":   {"Guri", "Preet"},
+	"harpreet":   {"Harry", "Preet"},
+	"manpreet":   {"Mannu"},
+	"jaspreet":   {"Jassi"},
+	"sukhwinder": {"Sukhi"},
+	"balwinder":  {"Billa"},
+	"kuldeep":    {"Kuldi"},
+	"simran":     {"Simmi"},
+	"navneet":    {"Neetu"},
+	"harleen":    {"Harlu"},
+}
+
+// region always writes Singh or Kaur, the caste-neutral Sikh surname,
+// and a clan name after it most of the time.
+var region = indic.Region{
+	Male: firstMale, Female: firstFemale, Neutral: firstNeutral,
+	Surnames:        lastNames,
+	NeutralSurnames: []string{"Singh"},
+	FemaleSurnames:  map[string]string{"Singh": "Kaur"},
+	Required:        "Singh",
+	ClanPct:         65,
+	InitialFather:   true,
+
+	// Gr and br onsets; given names shared by men and women end in -preet,
+	// -jit and -deep, and men's also in -inder; clan names in -u and -on.
+	Onsets: []string{
+		"", "",
+		"b", "bh", "d", "dh", "g", "gh", "gr", "br", "k", "kh", "m", "n",
+		"p", "r", "s", "t", "v", "j", "jh", "h", "l",
+	},
+	MaleEndings:    []string{"", "", "preet", "jit", "inder", "deep", "winder"},
+	FemaleEndings:  []string{"", "", "preet", "jit", "deep", "een", "jot"},
+	NeutralEndings: []string{"", "", "preet", "jit", "deep"},
+	SurnameEndings: []string{"", "", "u", "on", "al", "ar"},
+}
+
+func (p punjabiProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return region.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile punjabiProfile
@@ -4,8 +4,7 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/polynesian"
 )
 
 type samoanProfile struct{}
@@ -13,19 +12,59 @@ type samoanProfile struct{}
 const PROFILE = "samoan"
 
 func init() {
+	culture.Lang.MustValidate(givenMale, givenFemale, givenNeutral, surnames)
 	api.RegisterProfile(PROFILE, Profile)
 }
 
 func (p samoanProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Samoan-inspired names (ASCII): curated + phonotactic procedural fallback; deterministic",
+		"notes": "Samoan-inspired names with the koma liliu (ʻ) and macrons (-ascii folds them): curated + validated (C)V procedural fallback; deterministic",
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p samoanProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the respectful Susuga and Afioga and the titles for women,
+// used with the given name.
+func (p samoanProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Susuga", Weight: 30, Use: api.UseGiven},
+			{Text: "Tamaitai", Gender: "female", Weight: 15, Use: api.UseGiven},
+			{Text: "Faletua", Gender: "female", Weight: 5, Use: api.UseGiven},
+			{Text: "Afioga", Weight: 5, Use: api.UseGiven},
+			{Text: "Dr.", Weight: 3},
+		},
+	}
+}
+
+// NicknameRules doubles the open first syllable of longer names; there are
+// no curated nicknames.
+func (p samoanProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		OpenStem:    true,
+		Reduplicate: true,
+	}
+}
+
+// Names are written with the koma liliu (ʻ) and macrons; -ascii folds them.
 var givenMale = []string{
 	"Tui", "Mika", "Sione", "Ioane", "Manu", "Peni", "Luka", "Iosefa", "Tavita", "Kelepi",
-	"Faafoi", "Afa", "Toa", "Pita", "Tama", "Fetu", "Leota", "Faatoia", "Atoa", "Malie",
+	"Faʻafoi", "Afa", "Toa", "Pita", "Tama", "Fetu", "Leota", "Faʻatoia", "Atoa", "Malie",
 }
 
 var givenFemale = []string{
@@ -38,14 +77,14 @@ var givenNeutral = []string{
 }
 
 var surnames = []string{
-	"Tuimalealiifano", "Tuilagi", "Faumuina", "Malietoa", "Saelua", "Fepuleai", "Leota", "Toleafoa", "Tufuga", "Aiono",
+	"Tuimalealiʻifano", "Tuilagi", "Faumuina", "Malietoa", "Saelua", "Fepuleaʻi", "Leota", "Toleafoa", "Tufuga", "Aiono",
 }
 
-// Samoan-like phonotactics: mostly open syllables (C)V; "ng" appears in Polynesian.
+// Samoan phonotactics: open syllables (C)V; the velar nasal is written g.
+// The loan letters h, k and r only occur in curated names.
 var onsets = []string{
 	"", "",
-	"f", "g", "h", "k", "l", "m", "n", "p", "r", "s", "t", "v",
-	"ng",
+	"f", "g", "l", "m", "n", "p", "s", "t", "v",
 }
 
 var vowels = []string{
@@ -60,9 +99,48 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "a", "i", "o", "u"}
 var surnameEndings = []string{"", "", "", "toga", "lani", "mana", "toa"}
 
+// culture validates the names and marks procedural ones.
+var culture = polynesian.Culture{Lang: polynesian.Samoan}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	n := 3
+	if realism < 40 {
+		n = 2 + r.Intn(3)
+	} else if r.Intn(100) < 25 {
+		n = 2 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 35 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 4
+	if realism < 40 {
+		n = 3 + r.Intn(3) // 3..5
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 40 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p samoanProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	marks := polynesian.MarkRand(cfg)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -89,46 +167,10 @@ func (p samoanProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 3
-		if realism < 40 {
-			n = 2 + r.Intn(3)
-		} else if r.Intn(100) < 25 {
-			n = 2 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 35 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 4
-		if realism < 40 {
-			n = 3 + r.Intn(3) // 3..5
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 40 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
+	var err error
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -138,19 +180,25 @@ func (p samoanProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			first = api.PickRand(givenNeutral, r)
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first, err = culture.Procedural(marks, func() string { return genGivenProcedural(r, realism) })
+		if err != nil {
+			return api.NameResult{}, err
+		}
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last, err = culture.Procedural(marks, func() string { return genSurnameProcedural(r, realism) })
+			if err != nil {
+				return api.NameResult{}, err
+			}
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile samoanProfile
@@ -0,0 +1,163 @@
+package slavic
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+)
+
+// Sub-locales, selected with cfg.Family. Without one the locale is inferred
+// from each surname.
+const (
+	localePolish    = "pl"
+	localeRussian   = "ru"
+	localeCzech     = "cs"
+	localeSerbian   = "sr" // Serbian/Croatian/Bosnian: surnames do not inflect
+	localeBulgarian = "bg"
+)
+
+var localeAliases = map[string]string{
+	"pl": localePolish, "polish": localePolish, "poland": localePolish,
+	"ru": localeRussian, "russian": localeRussian, "russia": localeRussian,
+	"cs": localeCzech, "cz": localeCzech, "czech": localeCzech,
+	"sr": localeSerbian, "serbian": localeSerbian, "hr": localeSerbian, "croatian": localeSerbian, "bs": localeSerbian,
+	"bg": localeBulgarian, "bulgarian": localeBulgarian,
+}
+
+// Curated surnames per sub-locale, in their dictionary (masculine) form.
+var localeSurnames = map[string][]string{
+	localePolish: {
+		"Kowalski", "Nowak", "Zielinski", "Wojcik", "Kaminski", "Lewandowski", "Wisniewski", "Wozniak", "Kowalczyk", "Szymanski",
+		"Dabrowski", "Mazur", "Krawczyk", "Piotrowski", "Grabowski", "Zajac", "Pawlowski", "Michalski", "Wieczorek", "Jablonski",
+		"Nowicki", "Majewski", "Olszewski", "Czarny", "Wesoly",
+	},
+	localeRussian: {
+		"Ivanov", "Petrov", "Sokolov", "Smirnov", "Volkov", "Popov", "Kuznetsov", "Morozov", "Lebedev", "Fedorov",
+		"Vasiliev", "Novikov", "Pavlov", "Semenov", "Golubev", "Vinogradov", "Bogdanov", "Kozlov", "Orlov", "Belov",
+		"Ilyin", "Borodin", "Tolstoy", "Trubetskoy", "Uspensky",
+	},
+	localeCzech: {
+		"Novak", "Svoboda", "Dvorak", "Kral", "Hajek", "Bartos", "Novotny", "Cerny", "Prochazka", "Kucera",
+		"Vesely", "Horak", "Nemec", "Marek", "Pospisil", "Pokorny", "Ruzicka", "Benes", "Fiala", "Sedlacek",
+		"Dolezal", "Zeman", "Kolar", "Navratil", "Blazek",
+	},
+	localeSerbian: {
+		"Kovac", "Horvat", "Jovanovic", "Petrovic", "Stojanovic", "Nikolic", "Markovic", "Babic", "Maric", "Juric",
+		"Knezevic", "Djordjevic", "Ilic", "Pavlovic", "Popovic", "Radic", "Vukovic", "Tomic", "Lukic", "Kralj",
+	},
+	localeBulgarian: {
+		"Dimitrov", "Georgiev", "Petkov", "Nikolov", "Hristov", "Todorov", "Stoyanov", "Angelov", "Atanasov", "Iliev",
+		"Yordanov", "Kolev", "Marinov", "Popov", "Ivanov",
+	},
+}
+
+// Procedural surname endings per sub-locale (dictionary form).
+var localeEndings = map[string][]string{
+	localePolish:    {"ski", "ski", "ski", "cki", "ak", "ek", "czyk", "owicz", "ny"},
+	localeRussian:   {"ov", "ov", "ov", "ev", "in", "sky", "enko"},
+	localeCzech:     {"ak", "ek", "ek", "sky", "ny", "ka", "ar"},
+	localeSerbian:   {"ic", "ovic", "ovic", "evic", "ac"},
+	localeBulgarian: {"ov", "ov", "ev", "ski"},
+}
+
+// surnameLocale maps each curated surname to its sub-locale.
+var surnameLocale = func() map[string]string {
+	m := map[string]string{}
+	for _, loc := range []string{localeBulgarian, localeSerbian, localeCzech, localePolish, localeRussian} {
+		for _, s := range localeSurnames[loc] {
+			m[strings.ToLower(s)] = loc
+		}
+	}
+	return m
+}()
+
+// resolveLocale turns a cfg.Family value into a sub-locale ("" if none).
+func resolveLocale(family string) string {
+	return localeAliases[strings.ToLower(strings.TrimSpace(family))]
+}
+
+// inferLocale guesses the sub-locale of a surname from the curated lists
+// and its ending; "" means no inflection rule applies.
+func inferLocale(surname string) string {
+	s := strings.ToLower(surname)
+	if loc, ok := surnameLocale[s]; ok {
+		return loc
+	}
+	switch {
+	case hasAnySuffix(s, "ski", "cki", "dzki", "icz", "czyk"):
+		return localePolish
+	case hasAnySuffix(s, "sky", "cky"):
+		return localeCzech
+	case hasAnySuffix(s, "ov", "ev", "in", "yn", "oy"):
+		return localeRussian
+	case hasAnySuffix(s, "ic", "vic"):
+		return localeSerbian
+	}
+	return ""
+}
+
+// InflectSurname puts a dictionary-form surname into form for a sub-locale:
+// Polish -ski/-ska, Russian -ov/-ova and -sky/-skaya, Czech -ová and -ý/-á
+// (ASCII), Bulgarian -ov/-ova. Serbian/Croatian surnames do not inflect.
+func (p slavicProfile) InflectSurname(base string, form api.SurnameForm, locale string) string {
+	if base == "" || form == "" || form == api.SurnameMasculine {
+		return base
+	}
+	if loc := resolveLocale(locale); loc != "" {
+		locale = loc
+	} else {
+		locale = inferLocale(base)
+	}
+	s := strings.ToLower(base)
+	stem := func(n int) string { return base[:len(base)-n] }
+
+	switch locale {
+	case localeRussian:
+		switch {
+		case hasAnySuffix(s, "skiy", "skii", "oy"):
+			return stem(2) + "aya"
+		case hasAnySuffix(s, "sky"):
+			return stem(1) + "aya"
+		case hasAnySuffix(s, "ov", "ev", "in", "yn"):
+			return base + "a"
+		}
+	case localeBulgarian:
+		switch {
+		case hasAnySuffix(s, "ski"):
+			return stem(1) + "a"
+		case hasAnySuffix(s, "ov", "ev", "in"):
+			return base + "a"
+		}
+	case localePolish:
+		switch {
+		case hasAnySuffix(s, "ski", "cki", "dzki"):
+			return stem(1) + "a"
+		case hasAnySuffix(s, "ny", "ly", "ry"):
+			return stem(1) + "a"
+		}
+	case localeCzech:
+		switch {
+		case hasAnySuffix(s, "y"):
+			return stem(1) + "a"
+		case hasAnySuffix(s, "ec"):
+			return stem(2) + "cova"
+		case hasAnySuffix(s, "ek"):
+			return stem(2) + "kova"
+		case hasAnySuffix(s, "a", "o", "e"):
+			return stem(1) + "ova"
+		case hasAnySuffix(s, "i", "u"):
+			return base
+		}
+		return base + "ova"
+	}
+	return base
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suf := range suffixes {
+		if strings.HasSuffix(s, suf) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,11 +1,10 @@
 package slavic
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type slavicProfile struct{}
@@ -23,6 +22,46 @@ func (p slavicProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p slavicProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the Polish Pan/Pani and the Russian Gospodin/Gospozha.
+func (p slavicProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Pan", Gender: "male", Weight: 30},
+			{Text: "Pani", Gender: "female", Weight: 30},
+			{Text: "Gospodin", Gender: "male", Weight: 25},
+			{Text: "Gospozha", Gender: "female", Weight: 25},
+			{Text: "Dr.", Weight: 5},
+			{Text: "Inz.", Weight: 2},
+		},
+	}
+}
+
+// NicknameRules gives curated diminutives (Aleksandr -> Sasha), the endings
+// -ka and -ushka, and -ek for men.
+func (p slavicProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:      nicknames,
+		Suffixes:     []string{"ka", "ushka"},
+		MaleSuffixes: []string{"ek"},
+	}
+}
+
 // Curated given names (ASCII only; expand anytime).
 var firstMale = []string{
 	"Ivan", "Nikolai", "Dmitri", "Sergei", "Alexei", "Viktor", "Andrei", "Mikhail", "Pavel", "Yuri",
@@ -40,11 +79,12 @@ var firstNeutral = []string{
 	"Sasha", "Alex", "Misha", "Nika", "Noa", "Mila", "Toni", "Dani", "Gabi", "Ren",
 }
 
-// Curated surnames (ASCII; mix across Slavic regions; expand anytime).
+// Curated surnames (ASCII; mix across Slavic regions; expand anytime). They are
+// in dictionary (masculine) form; see morphology.go for the per-locale lists.
 var lastNames = []string{
 	"Ivanov", "Petrov", "Sokolov", "Smirnov", "Volkov", "Popov", "Kuznetsov", "Morozov", "Lebedev", "Novak",
 	"Kowalski", "Nowak", "Zielinski", "Wojcik", "Kaminski", "Lewandowski", "Kovac", "Horvat", "Jovanovic", "Petrovic",
-	"Dimitrov", "Ivanova", "Kral", "Svoboda", "Dvorak", "Hajek", "Bartos", "Stojanovic", "Nikolic", "Markovic",
+	"Dimitrov", "Fedorov", "Kral", "Svoboda", "Dvorak", "Hajek", "Bartos", "Stojanovic", "Nikolic", "Markovic",
 }
 
 // Procedural building blocks (Slavic-ish phonotactics; simple ASCII).
@@ -62,11 +102,149 @@ var givenEndingsMale = []string{"", "", "", "ov", "ev", "in", "sky", "ski", "ik"
 var givenEndingsFemale = []string{"", "", "", "a", "ia", "ina", "ova", "eva", "ska"}
 var givenEndingsNeutral = []string{"", "", "", "en", "in", "a"}
 
-var surnameEndings = []string{"", "", "", "ov", "ev", "in", "ski", "sky", "icz", "vic", "vich", "ova", "eva"}
+var surnameEndings = []string{"", "", "", "ov", "ev", "in", "ski", "sky", "icz", "vic", "vich", "ko", "ak"}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"aleksandr":  {"Sasha", "Sanya", "Shura"},
+	"alexander":  {"Sasha", "Sanya"},
+	"aleksandra": {"Sasha", "Ola"},
+	"alexandra":  {"Sasha"},
+	"ivan":       {"Vanya", "Vanyusha"},
+	"nikolai":    {"Kolya"},
+	"dmitri":     {"Dima", "Mitya"},
+	"sergei":     {"Seryozha", "Serezha"},
+	"alexei":     {"Alyosha", "Lyosha"},
+	"viktor":     {"Vitya"},
+	"andrei":     {"Andryusha"},
+	"mikhail":    {"Misha", "Mishka"},
+	"pavel":      {"Pasha"},
+	"yuri":       {"Yura"},
+	"boris":      {"Borya"},
+	"oleg":       {"Olezhka"},
+	"roman":      {"Roma"},
+	"kirill":     {"Kirya"},
+	"vladimir":   {"Volodya", "Vova", "Vlad"},
+	"stanislav":  {"Stas"},
+	"piotr":      {"Piotrek"},
+	"tomasz":     {"Tomek"},
+	"mateusz":    {"Mateuszek"},
+	"jan":        {"Janek"},
+	"stefan":     {"Stefek"},
+	"anna":       {"Anya", "Anka", "Hania"},
+	"olga":       {"Olya"},
+	"irina":      {"Ira"},
+	"natalia":    {"Natasha", "Tasha"},
+	"svetlana":   {"Sveta"},
+	"tatiana":    {"Tanya"},
+	"yelena":     {"Lena"},
+	"elena":      {"Lena"},
+	"nadia":      {"Nadya"},
+	"katarina":   {"Katya", "Kasia"},
+	"marina":     {"Marinka"},
+	"anastasia":  {"Nastya", "Asya"},
+	"daria":      {"Dasha"},
+	"vera":       {"Verochka"},
+	"agnieszka":  {"Aga", "Agusia"},
+	"ewa":        {"Ewka"},
+	"zuzana":     {"Zuzka"},
+	"tereza":     {"Terka"},
+	"magda":      {"Madzia"},
+	"marta":      {"Martusia"},
+}
+
+func genSyl(r api.RandLike) string {
+	// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel.
+	if r.Intn(100) < 75 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Ending by gender (light touch)
+	switch cfg.Gender {
+	case "male":
+		end := api.PickRand(givenEndingsMale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	case "female":
+		end := api.PickRand(givenEndingsFemale, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	default:
+		end := api.PickRand(givenEndingsNeutral, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Surname endings more likely at higher realism
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
+	}
+	if r.Intn(100) < thr {
+		end := api.PickRand(surnameEndings, r)
+		if end != "" && !strings.HasSuffix(b.String(), end) {
+			b.WriteString(end)
+		}
+	}
+
+	return b.String()
+}
+
+// genLocaleSurname builds a dictionary-form surname with a sub-locale ending.
+func genLocaleSurname(r api.RandLike, locale string) string {
+	numSyl := 1 + r.Intn(2) // 1..2, the ending adds one more
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+	end := api.PickRand(localeEndings[locale], r)
+	if !strings.HasSuffix(b.String(), end) {
+		b.WriteString(end)
+	}
+	return b.String()
+}
+
+// surnameForm is the surname form for a requested gender; neutral keeps the
+// dictionary form.
+func surnameForm(gender string) api.SurnameForm {
+	switch gender {
+	case "male":
+		return api.SurnameMasculine
+	case "female":
+		return api.SurnameFeminine
+	}
+	return ""
+}
 
 func (p slavicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -95,75 +273,10 @@ func (p slavicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Mostly onset+vowel(+optional coda), sometimes vowel+onset+vowel.
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Ending by gender (light touch)
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Surname endings more likely at higher realism
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-
-		return b.String()
-	}
 
 	// ---- First name selection ----
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -180,31 +293,40 @@ func (p slavicProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	// ---- Last name selection ----
+	// The surname is picked in its dictionary form and then inflected for
+	// gender by sub-locale (cfg.Family: polish, russian, czech, ...).
 	last := ""
+	var parts *api.NameParts
 	if cfg.IncludeLast {
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
+		base := ""
+		locale := resolveLocale(cfg.Family)
+		if locale != "" {
+			if api.Chance(r, useRealPct) {
+				base = api.PickRand(localeSurnames[locale], r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				base = api.Title(genLocaleSurname(r, locale))
 			}
 		} else {
-			// If Family override is something else, still produce Slavic-ish surname for now.
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
+			// No (or an unknown) sub-locale: the mixed list, locale inferred per surname.
+			if api.Chance(r, useRealPct) {
+				base = api.PickRand(lastNames, r)
 			} else {
-				last = caser.String(genSurnameProcedural())
+				base = api.Title(genSurnameProcedural(r, realism))
 			}
+			locale = inferLocale(base)
 		}
+		form := surnameForm(cfg.Gender)
+		last = p.InflectSurname(base, form, locale)
+		parts = &api.NameParts{Locale: locale, SurnameBase: base, SurnameForm: form}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+	first = api.Title(first)
+	last = api.Title(last)
+	return api.NameResult{First: first, Last: last, Parts: parts}, nil
 }
 
 // Profile is the core exported symbol
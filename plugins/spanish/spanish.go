@@ -1,11 +1,10 @@
 package spanish
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type spanishProfile struct{}
@@ -19,7 +18,55 @@ func init() {
 func (p spanishProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Spanish names: realism blends curated lists with procedural syllables; deterministic with seed",
+		"notes": "Spanish names: paternal + maternal surnames (conventions double, joined, surname), compound given names; realism blends curated lists with procedural syllables; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p spanishProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      slices.Concat(firstMale, compoundMale),
+		FirstFemale:    slices.Concat(firstFemale, compoundFemale),
+		FirstNeutral:   firstNeutral,
+		Last:           slices.Concat(lastNames, particleSurnames),
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives the Spanish titles, Don and Doña with the given name, and
+// the rare hijo.
+func (p spanishProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sr.", Gender: "male", Weight: 40},
+			{Text: "Sra.", Gender: "female", Weight: 35},
+			{Text: "Srta.", Gender: "female", Weight: 8},
+			{Text: "Don", Gender: "male", Weight: 15, Use: api.UseGiven},
+			{Text: "Doña", Gender: "female", Weight: 15, Use: api.UseGiven},
+			{Text: "Dr.", Gender: "male", Weight: 5},
+			{Text: "Dra.", Gender: "female", Weight: 5},
+			{Text: "Lic.", Weight: 4},
+			{Text: "Ing.", Weight: 3},
+			{Text: "Prof.", Weight: 2},
+		},
+		Suffixes: []api.Honorific{
+			{Text: "hijo", Gender: "male", Weight: 1},
+		},
+		SuffixPct: 3,
+	}
+}
+
+// NicknameRules gives curated short forms (Francisco -> Paco) and the
+// ending -ito/-ita.
+func (p spanishProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"ito"},
 	}
 }
 
@@ -40,12 +87,29 @@ var firstNeutral = []string{
 	"Alex", "Cruz", "Angel", "Noa", "Ariel", "Dani", "Gael", "Andrea", "Sam", "Rene",
 }
 
+// Compound given names, used in full ("Jose Luis", "Maria del Carmen").
+var compoundMale = []string{
+	"Jose Luis", "Juan Carlos", "Jose Maria", "Juan Antonio", "Jose Antonio", "Miguel Angel", "Francisco Javier",
+	"Juan Jose", "Luis Miguel", "Juan Pablo", "Jose Manuel", "Jose Ignacio",
+}
+
+var compoundFemale = []string{
+	"Maria Jose", "Maria del Carmen", "Ana Maria", "Maria Luisa", "Maria Teresa", "Maria Isabel", "Maria Jesus",
+	"Maria de los Angeles", "Maria del Pilar", "Ana Belen", "Maria Elena", "Rosa Maria",
+}
+
 var lastNames = []string{
 	"Garcia", "Gonzalez", "Rodriguez", "Fernandez", "Lopez", "Martinez", "Sanchez", "Perez", "Gomez", "Martin",
 	"Jimenez", "Ruiz", "Hernandez", "Diaz", "Moreno", "Munoz", "Alvarez", "Romero", "Alonso", "Gutierrez",
 	"Navarro", "Torres", "Dominguez", "Vazquez", "Ramos", "Gil", "Serrano", "Blanco", "Molina", "Morales",
 }
 
+// Surnames with particles ("del", "de la", "de los").
+var particleSurnames = []string{
+	"de la Fuente", "del Rio", "de la Cruz", "de Leon", "del Castillo", "de la Torre", "de los Santos", "de la Vega",
+	"del Valle", "de la Rosa", "de Miguel", "del Pino",
+}
+
 // Procedural building blocks to produce Spanish-ish phonotactics.
 var vowels = []string{"a", "e", "i", "o", "u"}
 
@@ -62,9 +126,123 @@ var codas = []string{"", "", "", "n", "s", "r", "l", "d"}
 var givenEndings = []string{"", "", "", "a", "o", "ia", "io", "el", "in"}
 var surnameEndings = []string{"", "", "", "ez", "es", "ado", "era", "ero", "osa", "illo"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"francisco":  {"Paco", "Pancho", "Curro", "Fran"},
+	"jose":       {"Pepe", "Chepe"},
+	"antonio":    {"Toño", "Toni"},
+	"manuel":     {"Manolo", "Manu"},
+	"javier":     {"Javi"},
+	"alejandro":  {"Alex", "Ale", "Jandro"},
+	"eduardo":    {"Edu", "Lalo"},
+	"guillermo":  {"Memo", "Guille"},
+	"ignacio":    {"Nacho"},
+	"jesus":      {"Chucho", "Chuy"},
+	"luis":       {"Lucho", "Luisito"},
+	"ricardo":    {"Richi"},
+	"roberto":    {"Beto"},
+	"alberto":    {"Beto"},
+	"fernando":   {"Nando", "Fer"},
+	"rafael":     {"Rafa"},
+	"enrique":    {"Quique"},
+	"carlos":     {"Carlitos"},
+	"miguel":     {"Migue"},
+	"sergio":     {"Checo"},
+	"maria":      {"Mari", "Maru"},
+	"dolores":    {"Lola"},
+	"concepcion": {"Concha", "Conchita"},
+	"guadalupe":  {"Lupe", "Lupita"},
+	"teresa":     {"Tere"},
+	"isabel":     {"Isa", "Chabela"},
+	"cristina":   {"Cris"},
+	"rosario":    {"Charo"},
+	"daniela":    {"Dani"},
+	"natalia":    {"Nati"},
+	"beatriz":    {"Bea"},
+	"mercedes":   {"Merche"},
+	"pilar":      {"Pili"},
+	"ana":        {"Anita"},
+	"juan":       {"Juanito", "Juancho"},
+
+	"jose luis":        {"Joselu"},
+	"jose maria":       {"Chema", "Josema"},
+	"juan carlos":      {"Juanca"},
+	"miguel angel":     {"Miguelan"},
+	"francisco javier": {"Fran", "Javi"},
+	"maria jose":       {"Majo", "Marijose"},
+	"maria del carmen": {"Mamen", "Maricarmen"},
+	"maria teresa":     {"Maite"},
+	"maria isabel":     {"Maribel"},
+	"maria luisa":      {"Malu", "Marisa"},
+	"maria jesus":      {"Chus", "Marichu"},
+	"maria del pilar":  {"Pili", "Mapi"},
+}
+
+func genSyl(r api.RandLike) string {
+	// Mostly CV(+optional coda), sometimes VCV
+	if r.Intn(100) < 70 {
+		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+	}
+	return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+}
+
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3) // 1..3
+	}
+
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	end := api.PickRand(givenEndings, r)
+	if end != "" && !strings.HasSuffix(b.String(), end) {
+		b.WriteString(end)
+	}
+
+	// small gender nuance at higher realism
+	if cfg.Gender == "female" && realism >= 70 && r.Intn(100) < 20 {
+		if !strings.HasSuffix(b.String(), "a") {
+			b.WriteString("a")
+		}
+	}
+	if cfg.Gender == "male" && realism >= 70 && r.Intn(100) < 20 {
+		if strings.HasSuffix(b.String(), "a") {
+			s := b.String()
+			b.Reset()
+			b.WriteString(strings.TrimSuffix(s, "a"))
+			b.WriteString("o")
+		}
+	}
+
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	numSyl := 2 + r.Intn(2) // 2..3
+	var b strings.Builder
+	for i := 0; i < numSyl; i++ {
+		b.WriteString(genSyl(r))
+	}
+
+	// Spanish-ish patronymic endings become more likely at higher realism
+	thr := 25
+	if realism >= 80 {
+		thr = 55
+	} else if realism >= 60 {
+		thr = 40
+	}
+	if r.Intn(100) < thr {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+
+	return b.String()
+}
+
 func (p spanishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Spanish)
 
 	// clamp realism
 	realism := cfg.Realism
@@ -94,74 +272,10 @@ func (p spanishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Mostly CV(+optional coda), sometimes VCV
-		if r.Intn(100) < 70 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
-	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3) // 1..3
-		}
-
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		end := api.PickRand(givenEndings, r)
-		if end != "" && !strings.HasSuffix(b.String(), end) {
-			b.WriteString(end)
-		}
-
-		// small gender nuance at higher realism
-		if cfg.Gender == "female" && realism >= 70 && r.Intn(100) < 20 {
-			if !strings.HasSuffix(b.String(), "a") {
-				b.WriteString("a")
-			}
-		}
-		if cfg.Gender == "male" && realism >= 70 && r.Intn(100) < 20 {
-			if strings.HasSuffix(b.String(), "a") {
-				s := b.String()
-				b.Reset()
-				b.WriteString(strings.TrimSuffix(s, "a"))
-				b.WriteString("o")
-			}
-		}
-
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2) // 2..3
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-
-		// Spanish-ish patronymic endings become more likely at higher realism
-		thr := 25
-		if realism >= 80 {
-			thr = 55
-		} else if realism >= 60 {
-			thr = 40
-		}
-		if r.Intn(100) < thr {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-
-		return b.String()
-	}
 
 	// ---- First name selection ----
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -177,33 +291,84 @@ func (p spanishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 				first = api.PickRand(firstFemale, r)
 			}
 		}
+		first = compoundGiven(r, cfg, first)
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	// ---- Last name selection ----
+	// Two surnames, the father's first then the mother's first; "joined"
+	// puts "y" between them and "surname" keeps only the father's.
 	last := ""
+	var parts *api.NameParts
 	if cfg.IncludeLast {
-		// honor Family override, but keep Spanish-ish behavior if unset or spanish
-		if cfg.Family == "" || strings.EqualFold(cfg.Family, PROFILE) {
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSurnameProcedural())
+		convention := resolveConvention(cfg.Convention)
+		pick := func() string {
+			switch {
+			case !api.Chance(r, useRealPct):
+				return api.Title(genSurnameProcedural(r, realism))
+			case api.Chance(r, 10):
+				return api.PickRand(particleSurnames, r)
 			}
-		} else {
-			// If Family override is something else, still produce Spanish-ish surname for now
-			if chooseFromReal() {
-				last = api.PickRand(lastNames, r)
-			} else {
-				last = caser.String(genSurnameProcedural())
+			return api.PickRand(lastNames, r)
+		}
+
+		parts = &api.NameParts{Convention: convention, GivenNames: api.GivenComponents(first), Paternal: pick()}
+		parts.Surnames = []string{parts.Paternal}
+		last = parts.Paternal
+		if convention != api.ConventionSurname {
+			parts.Maternal = pick()
+			for i := 0; i < 3 && parts.Maternal == parts.Paternal; i++ {
+				parts.Maternal = pick()
 			}
+			parts.Surnames = append(parts.Surnames, parts.Maternal)
+			sep := " "
+			if convention == api.ConventionJoined {
+				sep = " " + conjunction(parts.Maternal) + " "
+			}
+			last = parts.Paternal + sep + parts.Maternal
 		}
 	}
 
-	first = caser.String(first)
-	last = caser.String(last)
-	return api.NameResult{First: first, Last: last}, nil
+	return api.NameResult{First: first, Last: last, Parts: parts}, nil
+}
+
+// compoundGiven sometimes replaces a curated given name with a compound
+// one (cfg.Compound percent, 15 by default).
+func compoundGiven(r api.RandLike, cfg api.ProfileConfig, first string) string {
+	pct := cfg.Compound
+	if pct == 0 {
+		pct = 15
+	}
+	if pct < 0 || !api.Chance(r, pct) {
+		return first
+	}
+	switch cfg.Gender {
+	case "male":
+		return api.PickRand(compoundMale, r)
+	case "female":
+		return api.PickRand(compoundFemale, r)
+	}
+	return first
+}
+
+// resolveConvention returns cfg.Convention when it applies here, otherwise
+// double surnames.
+func resolveConvention(convention string) string {
+	switch c := strings.ToLower(strings.TrimSpace(convention)); c {
+	case api.ConventionSurname, api.ConventionJoined:
+		return c
+	}
+	return api.ConventionDouble
+}
+
+// conjunction is "y", or "e" before an i- sound ("Garcia e Iglesias").
+func conjunction(next string) string {
+	l := strings.ToLower(next)
+	if strings.HasPrefix(l, "i") || (strings.HasPrefix(l, "hi") && !strings.HasPrefix(l, "hie")) {
+		return "e"
+	}
+	return "y"
 }
 
 // Profile is the core exported symbol
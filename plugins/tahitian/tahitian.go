@@ -0,0 +1,84 @@
+package tahitian
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/polynesian"
+)
+
+type tahitianProfile struct{}
+
+const PROFILE = "tahitian"
+
+func init() {
+	culture.Lang.MustValidate(givenMale, givenFemale, givenNeutral, surnames)
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p tahitianProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Tahitian names with the ʻeta (ʻ) and macrons (-ascii folds them): curated + validated (C)V procedural fallback; deterministic",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p tahitianProfile) Inventory() api.Inventory {
+	return culture.Inventory()
+}
+
+// Forms gives the French titles used in French Polynesia and Tavana.
+func (p tahitianProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "M.", Gender: "male", Weight: 30},
+			{Text: "Mme", Gender: "female", Weight: 30},
+			{Text: "Tavana", Weight: 3},
+			{Text: "Dr.", Weight: 3},
+		},
+	}
+}
+
+// NicknameRules doubles the open first syllable of longer names; there are
+// no curated nicknames.
+func (p tahitianProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		OpenStem:    true,
+		Reduplicate: true,
+	}
+}
+
+// Names are written with the ʻeta (ʻ) and macrons; -ascii folds them.
+// Tahitian has no k, l or s.
+var givenMale = []string{
+	"Teva", "Tehau", "Manu", "Heimana", "Tamatoa", "Matahi", "Hiro", "Tāne", "Hotu", "Teriitehau",
+	"Rauhiti", "Tavita", "Ariʻi", "Toa", "Moana", "Vaitea", "Raitini", "Tumata", "Poehere", "Nohorai",
+}
+
+var givenFemale = []string{
+	"Hinano", "Vaimiti", "Poerava", "Titaua", "Moana", "Teura", "Tiare", "Hina", "Mahina", "Māeva",
+	"Vaihere", "Heiata", "Moerani", "Turia", "Tehani", "Hereiti", "Maruia", "Vahinerii", "Rātea", "Poema",
+}
+
+var givenNeutral = []string{
+	"Moana", "Teva", "Manu", "Māeva", "Heimana", "Tiare", "Hiro", "Toa",
+}
+
+var surnames = []string{
+	"Temaru", "Tetuanui", "Teihotu", "Tuheiava", "Raʻapoto", "Tehei", "Teriierooiterai", "Tapati", "Atiau", "Maruhi",
+	"Teriitahi", "Vaiarii", "Tauraʻa", "Tehaamoana", "Faatau", "Teumere", "Pōmare", "Tavaearii", "Hapaiʻuri", "Temauri",
+}
+
+var culture = polynesian.Culture{
+	Lang: polynesian.Tahitian,
+	Male: givenMale, Female: givenFemale, Neutral: givenNeutral,
+	Surnames:       surnames,
+	GivenEndings:   []string{"", "", "", "a", "i", "e", "rii"},
+	SurnameEndings: []string{"", "", "", "nui", "rii", "moana", "tea"},
+}
+
+func (p tahitianProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return culture.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile tahitianProfile
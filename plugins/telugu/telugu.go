@@ -0,0 +1,112 @@
+package telugu
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/indic"
+)
+
+type teluguProfile struct{}
+
+const PROFILE = "telugu"
+
+func init() {
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p teluguProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Telugu names (romanized, ASCII): house name first (Nandamuri Karthik), curated + shared Indic phonotactics; conventions surname, initials (N. Karthik), patronymic, caste-neutral; deterministic with seed",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p teluguProfile) Inventory() api.Inventory {
+	return region.Inventory()
+}
+
+// Forms gives Sri and Srimathi, and garu after the given name.
+func (p teluguProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Sri", Gender: "male", Weight: 40},
+			{Text: "Srimathi", Gender: "female", Weight: 30},
+			{Text: "garu", Weight: 20, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Dr.", Weight: 8},
+		},
+	}
+}
+
+// NicknameRules gives curated pet names and the endings -u and -a.
+func (p teluguProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"u", "a"},
+	}
+}
+
+// FormPatronymic lets family trees use the patronymic convention.
+func (p teluguProfile) FormPatronymic(parent string, matronymic bool, gender, locale string) string {
+	return region.FormPatronymic(parent, matronymic, gender, locale)
+}
+
+var firstMale = []string{
+	"Venkatesh", "Srinivas", "Ravi", "Chiranjeevi", "Mahesh", "Prabhas", "Ramakrishna", "Nagarjuna", "Suresh", "Kiran",
+	"Sai", "Pawan", "Satish", "Naresh", "Raghu", "Anil", "Vamsi", "Chaitanya", "Harish", "Srikanth",
+}
+
+var firstFemale = []string{
+	"Lakshmi", "Padmavathi", "Sravani", "Swapna", "Anusha", "Bhavani", "Sirisha", "Haritha", "Kalyani", "Sailaja",
+	"Mounika", "Sowmya", "Divya", "Lavanya", "Madhavi", "Ramya", "Tejaswini", "Aruna", "Jyothi", "Keerthana",
+}
+
+var firstNeutral = []string{
+	"Sai", "Kiran", "Teja", "Hari", "Ravi", "Chaitanya", "Anu", "Sri",
+}
+
+// House names (intiperu), written before the given name.
+var lastNames = []string{
+	"Nandamuri", "Konidela", "Akkineni", "Daggubati", "Ghattamaneni", "Kotagiri", "Vemuri", "Pasupuleti", "Gollapudi", "Yarlagadda",
+	"Chalasani", "Bommareddy", "Kandukuri", "Tummala", "Allu", "Manchu", "Ravuri", "Kothapalli", "Nallamothu", "Uppalapati",
+}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"venkatesh":   {"Venky"},
+	"srinivas":    {"Cheenu", "Seenu"},
+	"chiranjeevi": {"Chiru"},
+	"ramakrishna": {"Rama"},
+	"nagarjuna":   {"Nag"},
+	"chaitanya":   {"Chaitu"},
+	"srikanth":    {"Sri"},
+	"lakshmi":     {"Lachi"},
+	"padmavathi":  {"Padma"},
+	"tejaswini":   {"Teju"},
+}
+
+// region puts the house name first and abbreviates it in initials.
+var region = indic.Region{
+	Male: firstMale, Female: firstFemale, Neutral: firstNeutral,
+	Surnames:      lastNames,
+	FamilyFirst:   true,
+	InitialFamily: true,
+
+	// Sr and ch onsets; -aiah and -ulu for men, -avathi and -amma for
+	// women, house names in -palli and -pudi.
+	Onsets: []string{
+		"", "",
+		"b", "bh", "d", "g", "k", "m", "n", "p", "r", "s", "sh",
+		"t", "v", "y", "ch", "j", "h", "l", "sr", "kr", "pr",
+	},
+	MaleEndings:    []string{"", "", "", "esh", "aiah", "ulu", "ayya", "a"},
+	FemaleEndings:  []string{"", "", "", "a", "avathi", "ani", "amma", "i"},
+	NeutralEndings: []string{"", "", "", "i", "a", "u"},
+	SurnameEndings: []string{"", "", "palli", "pudi", "puri", "la", "di"},
+}
+
+func (p teluguProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return region.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile teluguProfile
@@ -0,0 +1,85 @@
+package tongan
+
+import (
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/internal/polynesian"
+)
+
+type tonganProfile struct{}
+
+const PROFILE = "tongan"
+
+func init() {
+	culture.Lang.MustValidate(givenMale, givenFemale, givenNeutral, surnames)
+	api.RegisterProfile(PROFILE, Profile)
+}
+
+func (p tonganProfile) Info() map[string]string {
+	return map[string]string{
+		"name":  PROFILE,
+		"notes": "Tongan names with the fakauʻa (ʻ) and macrons (-ascii folds them): curated + validated (C)V procedural fallback; deterministic",
+	}
+}
+
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p tonganProfile) Inventory() api.Inventory {
+	return culture.Inventory()
+}
+
+// Forms gives the English titles and Faifekau with the given name.
+func (p tonganProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Mr.", Gender: "male", Weight: 30},
+			{Text: "Ms.", Gender: "female", Weight: 30},
+			{Text: "Faifekau", Weight: 8, Use: api.UseGiven},
+			{Text: "Hon.", Weight: 2},
+			{Text: "Dr.", Weight: 3},
+		},
+	}
+}
+
+// NicknameRules doubles the open first syllable of longer names; there are
+// no curated nicknames.
+func (p tonganProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		OpenStem:    true,
+		Reduplicate: true,
+	}
+}
+
+// Names are written with the fakauʻa (ʻ) and macrons; -ascii folds them.
+var givenMale = []string{
+	"Sione", "Tevita", "Viliami", "Siaosi", "Sitiveni", "Taniela", "Semisi", "ʻAlipate", "Paula", "Soane",
+	"Tomasi", "Feleti", "Sāmiuela", "Pita", "Lopeti", "Mosese", "Siosaia", "ʻIsileli", "Maka", "Tēvita",
+}
+
+var givenFemale = []string{
+	"Mele", "Lavinia", "Sālote", "Losaline", "ʻAna", "Seini", "Luseane", "Tupou", "ʻOfa", "Sela",
+	"Meleane", "Kalolaine", "Siosiana", "Vika", "Lesieli", "Mālia", "Sisilia", "Heilala", "ʻAna Mele", "Fifita",
+}
+
+var givenNeutral = []string{
+	"ʻOfa", "Tupou", "Heilala", "Sela", "Maka", "Pita", "Fifita", "Sione",
+}
+
+// Family names; many Tongans also take the father's given name as a surname.
+var surnames = []string{
+	"Tupou", "Fifita", "Vainikolo", "Taufa", "Havili", "Kata", "Moala", "Lātū", "Fonua", "Vaipulu",
+	"Fakatava", "Tuipulotu", "Pulu", "Kioa", "Hufanga", "Fusitūʻa", "Taumalolo", "Lolohea", "Mahe", "Fīnau",
+}
+
+var culture = polynesian.Culture{
+	Lang: polynesian.Tongan,
+	Male: givenMale, Female: givenFemale, Neutral: givenNeutral,
+	Surnames:       surnames,
+	GivenEndings:   []string{"", "", "", "a", "i", "e", "ni"},
+	SurnameEndings: []string{"", "", "", "tonga", "lolo", "fonua", "mana"},
+}
+
+func (p tonganProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	return culture.Generate(cfg)
+}
+
+// Profile is the core exported symbol
+var Profile tonganProfile
@@ -1,11 +1,10 @@
 package turkish
 
 import (
-	"strings"
+	"slices"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/turkic"
 )
 
 type turkishProfile struct{}
@@ -19,21 +18,58 @@ func init() {
 func (p turkishProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Turkish names (ASCII): realism blends curated lists with procedural syllables; deterministic with seed",
+		"notes": "Turkish names in Turkish spelling (ç, ğ, ı, ş; -ascii folds them); procedural names and suffixes follow vowel harmony",
 	}
 }
 
-// Curated given names (ASCII; diacritics removed, e.g., Ş->S, ğ->g, ı->i, ö->o, ü->u, ç->c).
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p turkishProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      firstMale,
+		FirstFemale:    firstFemale,
+		FirstNeutral:   firstNeutral,
+		Last:           lastNames,
+		NeutralMixes:   true,
+		Onsets:         lang.Onsets,
+		Nuclei:         lang.Nuclei,
+		Codas:          lang.Codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Bey, Hanım and Hoca after the given name.
+func (p turkishProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Bey", Gender: "male", Weight: 60, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Hanım", Gender: "female", Weight: 60, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Dr.", Weight: 6},
+			{Text: "Hoca", Weight: 4, Use: api.UseGiven, After: true, Join: " "},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms and the endearments -cim and
+// -cik.
+func (p turkishProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		Suffixes: []string{"cim", "cik"},
+	}
+}
+
+// Curated names in Turkish spelling; -ascii folds them (Ş->S, ğ->g, ı->i).
 var firstMale = []string{
-	"Mehmet", "Mustafa", "Ahmet", "Ali", "Emre", "Murat", "Yusuf", "Osman", "Hasan", "Huseyin",
-	"Kerem", "Can", "Burak", "Omer", "Eren", "Serkan", "Cem", "Kaan", "Baris", "Deniz",
-	"Onur", "Ibrahim", "Halil", "Suleyman", "Fatih", "Sinan", "Cenk", "Umut", "Tolga", "Taylan",
+	"Mehmet", "Mustafa", "Ahmet", "Ali", "Emre", "Murat", "Yusuf", "Osman", "Hasan", "Hüseyin",
+	"Kerem", "Can", "Burak", "Ömer", "Eren", "Serkan", "Cem", "Kaan", "Barış", "Deniz",
+	"Onur", "İbrahim", "Halil", "Süleyman", "Fatih", "Sinan", "Cenk", "Umut", "Tolga", "Taylan",
 }
 
 var firstFemale = []string{
-	"Ayse", "Fatma", "Emine", "Zeynep", "Elif", "Merve", "Seda", "Esra", "Ebru", "Ceren",
-	"Selin", "Derya", "Deniz", "Buse", "Gul", "Asli", "Hande", "Yasemin", "Aylin", "Melis",
-	"Sibel", "Sevgi", "Nazan", "Tugce", "Ece", "Pinar", "Aysun", "Gizem", "Nazli", "Damla",
+	"Ayşe", "Fatma", "Emine", "Zeynep", "Elif", "Merve", "Seda", "Esra", "Ebru", "Ceren",
+	"Selin", "Derya", "Deniz", "Buse", "Gül", "Aslı", "Hande", "Yasemin", "Aylin", "Melis",
+	"Sibel", "Sevgi", "Nazan", "Tuğçe", "Ece", "Pınar", "Aysun", "Gizem", "Nazlı", "Damla",
 }
 
 var firstNeutral = []string{
@@ -41,117 +77,71 @@ var firstNeutral = []string{
 }
 
 var lastNames = []string{
-	"Yilmaz", "Kaya", "Demir", "Sahin", "Celik", "Yildiz", "Aydin", "Ozdemir", "Arslan", "Dogan",
-	"Kilic", "Koc", "Aslan", "Yavuz", "Ozturk", "Erdogan", "Polat", "Aksoy", "Gunes", "Bulut",
-	"Kaplan", "Karaca", "Toprak", "Tas", "Tekin", "Ekinci", "Eren", "Kurt", "Yalcin", "Sari",
+	"Yılmaz", "Kaya", "Demir", "Şahin", "Çelik", "Yıldız", "Aydın", "Özdemir", "Arslan", "Doğan",
+	"Kılıç", "Koç", "Aslan", "Yavuz", "Öztürk", "Erdoğan", "Polat", "Aksoy", "Güneş", "Bulut",
+	"Kaplan", "Karaca", "Toprak", "Taş", "Tekin", "Ekinci", "Eren", "Kurt", "Yalçın", "Sarı",
 }
 
-// Procedural building blocks (Turkish-ish, vowel harmony not enforced; ASCII).
-var vowels = []string{"a", "e", "i", "o", "u", "ai", "ei", "ia", "io", "ua"}
-var onsets = []string{
-	"", "",
-	"b", "c", "d", "f", "g", "h", "k", "l", "m", "n", "p", "r", "s", "t", "v", "y", "z",
-	"ch", "sh",
-	"kr", "tr", "pr", "gr",
+// Procedural names follow Turkish vowel harmony (see turkic). Endings are
+// suffix templates: {A} and {I} harmonize, "+" joins a word of its own.
+var lang = &turkic.Turkish
+
+var givenEndingsMale = []string{"", "", "", "+han", "+can", "{A}r", "{I}n", "{A}n", "+tay"}
+var givenEndingsFemale = []string{"", "", "", "{A}", "{I}n", "s{A}l", "nur", "gül", "su"}
+var givenEndingsNeutral = []string{"", "", "", "{A}", "{I}n", "{A}r"}
+
+var surnameEndings = []string{"", "", "", "+oğlu", "+soy", "l{I}", "{C}{I}", "{A}r", "+taş"}
+
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"mehmet":  {"Memo"},
+	"mustafa": {"Musti"},
+	"ahmet":   {"Ahmo"},
+	"hüseyin": {"Hüso"},
+	"ibrahim": {"İbo"},
+	"ismail":  {"İsko"},
+	"fatma":   {"Fatoş"},
+	"ayşe":    {"Ayşeş"},
+	"zeynep":  {"Zey", "Zeyno"},
+	"emine":   {"Emoş"},
 }
-var codas = []string{"", "", "", "n", "m", "r", "l", "k", "t", "s"}
-
-var givenEndingsMale = []string{"", "", "", "han", "can", "em", "er", "in", "an"}
-var givenEndingsFemale = []string{"", "", "", "a", "e", "in", "nur", "sel", "gul"}
-var givenEndingsNeutral = []string{"", "", "", "a", "e", "in", "er"}
 
-var surnameEndings = []string{"", "", "", "oglu", "soy", "li", "er", "ci"}
-
-func (p turkishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
-	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
-
-	realism := cfg.Realism
-	if realism < 0 {
-		realism = 0
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	numSyl := 2 + r.Intn(2)
+	if realism < 40 {
+		numSyl = 1 + r.Intn(3)
 	}
-	if realism > 100 {
-		realism = 100
+	word := lang.Word(r, numSyl)
+	switch cfg.Gender {
+	case "male":
+		return lang.Ending(r, word, givenEndingsMale)
+	case "female":
+		return lang.Ending(r, word, givenEndingsFemale)
 	}
+	return lang.Ending(r, word, givenEndingsNeutral)
+}
 
-	useRealPct := 0
-	switch {
-	case realism >= 95:
-		useRealPct = 95
-	case realism >= 90:
-		useRealPct = 90
-	case realism >= 80:
-		useRealPct = 80
-	case realism >= 70:
-		useRealPct = 55
-	case realism >= 60:
-		useRealPct = 35
-	case realism >= 40:
-		useRealPct = 20
-	default:
-		useRealPct = 5
-	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		if r.Intn(100) < 75 {
-			return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		}
-		return api.PickRand(vowels, r) + api.PickRand(onsets, r) + api.PickRand(vowels, r)
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	word := lang.Word(r, 2+r.Intn(2))
+	thr := 20
+	if realism >= 80 {
+		thr = 45
+	} else if realism >= 60 {
+		thr = 30
 	}
-
-	genGivenProcedural := func() string {
-		numSyl := 2 + r.Intn(2)
-		if realism < 40 {
-			numSyl = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-		switch cfg.Gender {
-		case "male":
-			end := api.PickRand(givenEndingsMale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		case "female":
-			end := api.PickRand(givenEndingsFemale, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		default:
-			end := api.PickRand(givenEndingsNeutral, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
+	if r.Intn(100) < thr {
+		return lang.Ending(r, word, surnameEndings)
 	}
+	return word
+}
 
-	genSurnameProcedural := func() string {
-		numSyl := 2 + r.Intn(2)
-		var b strings.Builder
-		for i := 0; i < numSyl; i++ {
-			b.WriteString(genSyl())
-		}
-		thr := 20
-		if realism >= 80 {
-			thr = 45
-		} else if realism >= 60 {
-			thr = 30
-		}
-		if r.Intn(100) < thr {
-			end := api.PickRand(surnameEndings, r)
-			if end != "" && !strings.HasSuffix(b.String(), end) {
-				b.WriteString(end)
-			}
-		}
-		return b.String()
-	}
+func (p turkishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(firstMale, r)
@@ -168,20 +158,17 @@ func (p turkishProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error)
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = turkic.Title(genGivenProcedural(r, cfg, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(lastNames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = turkic.Title(genSurnameProcedural(r, realism))
 		}
 	}
-
-	first = caser.String(first)
-	last = caser.String(last)
 	return api.NameResult{First: first, Last: last}, nil
 }
 
@@ -1,11 +1,11 @@
 package uzbek
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
+	"github.com/nsa-yoda/namegen/plugins/internal/turkic"
 )
 
 type uzbekProfile struct{}
@@ -19,13 +19,58 @@ func init() {
 func (p uzbekProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Uzbek/Central Asian inspired names (ASCII): curated + procedural fallback; deterministic",
+		"notes": "Uzbek names in the Latin alphabet (oʻ, gʻ), or Cyrillic with -script cyrillic; -ov/-ova surnames",
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p uzbekProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         lang.Onsets,
+		Nuclei:         lang.Nuclei,
+		Codas:          lang.Codas,
+		GivenEndings:   slices.Concat(givenEndingsMale, givenEndingsFemale, givenEndingsNeutral),
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives aka, opa, Xonim and domla after the given name, and Janob.
+func (p uzbekProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "aka", Gender: "male", Weight: 40, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "opa", Gender: "female", Weight: 40, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "Janob", Gender: "male", Weight: 15},
+			{Text: "Xonim", Gender: "female", Weight: 15, Use: api.UseGiven, After: true, Join: " "},
+			{Text: "domla", Weight: 5, Use: api.UseGiven, After: true, Join: " "},
+		},
+	}
+}
+
+// Scripts lists the native script: Cyrillic.
+func (p uzbekProfile) Scripts() []string {
+	return []string{turkic.ScriptCyrillic}
+}
+
+// NicknameRules adds the endearment -jon, and -boy for men, to longer
+// names; there are no curated nicknames.
+func (p uzbekProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Suffixes:     []string{"jon"},
+		MaleSuffixes: []string{"boy"},
+	}
+}
+
+// Curated names in the Uzbek Latin alphabet (oʻ, gʻ, sh, ch, x); Cyrillic
+// is rendered from it (turkic.UzbekCyrillic).
 var givenMale = []string{
-	"Aziz", "Bekzod", "Jasur", "Sardor", "Rustam", "Shavkat", "Ulugbek", "Temur", "Akmal", "Dilshod",
-	"Farrukh", "Kamol", "Bunyod", "Odil", "Asad", "Sherzod", "Islom", "Siroj", "Anvar", "Jamshid",
+	"Aziz", "Bekzod", "Jasur", "Sardor", "Rustam", "Shavkat", "Ulugʻbek", "Temur", "Akmal", "Dilshod",
+	"Farrux", "Kamol", "Bunyod", "Odil", "Asad", "Sherzod", "Islom", "Siroj", "Anvar", "Jamshid",
 }
 
 var givenFemale = []string{
@@ -37,141 +82,132 @@ var givenNeutral = []string{
 	"Aziz", "Aziza", "Madina", "Malika", "Dilshod", "Zarina", "Kamol", "Odil", "Shirin", "Anvar",
 }
 
+// Curated surnames, in the men's form.
 var surnames = []string{
-	"Karimov", "Rakhimov", "Yusupov", "Abdullayev", "Ismailov", "Nazarov", "Tursunov", "Saidov", "Khodjaev", "Qodirov",
-	"Aliyev", "Usmonov", "Soliev", "Mamatov", "Shukurov",
+	"Karimov", "Rahimov", "Yusupov", "Abdullayev", "Ismoilov", "Nazarov", "Tursunov", "Saidov", "Xoʻjayev", "Qodirov",
+	"Aliyev", "Usmonov", "Soliyev", "Mamatov", "Shukurov",
 }
 
-var onsets = []string{
-	"", "",
-	"b", "d", "f", "g", "h", "j", "k", "l", "m", "n", "p", "q", "r", "s", "t", "v", "y", "z",
-	"sh", "ch", "kh", "zh",
-	"br", "kr", "tr",
-}
+// Uzbek has lost vowel harmony, so procedural names only share the
+// subsystem's syllables and suffix rules (see turkic).
+var lang = &turkic.Uzbek
 
-var vowels = []string{
-	"a", "e", "i", "o", "u", "y",
-	"ai", "au", "ia", "ie", "oi", "ua",
-}
+var givenEndingsMale = []string{"", "", "", "+bek", "+jon", "+mir", "+xon", "+dor", "+shod"}
+var givenEndingsFemale = []string{"", "", "", "a", "ya", "+noza", "+nora", "+gul", "+oy"}
+var givenEndingsNeutral = []string{"", "", "", "a", "an", "+bek", "+oy"}
 
-var codas = []string{
-	"", "", "", "",
-	"n", "m", "r", "l", "t", "k", "s",
-	"ng",
-}
+var surnameEndings = []string{"{OV}", "{OV}", "{OV}", "+bek{OV}", "+boy{OV}", "+zoda"}
 
-var givenEndingsMale = []string{"", "", "", "bek", "jon", "mir", "khon", "dor", "shod"}
-var givenEndingsFemale = []string{"", "", "", "a", "ya", "noza", "nora", "gul", "oy"}
-var givenEndingsNeutral = []string{"", "", "", "a", "an", "bek", "oy"}
+// Women's surname endings.
+var feminine = [][2]string{{"ov", "ova"}, {"ev", "eva"}, {"in", "ina"}}
 
-var surnameEndings = []string{"ov", "ova", "ev", "eva", "bekov", "bayev", "zoda"}
+func genGivenProcedural(r api.RandLike, cfg api.ProfileConfig, realism int) string {
+	n := 2
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	} else if r.Intn(100) < 30 {
+		n = 2 + r.Intn(2)
+	}
+	word := lang.Word(r, n)
+	switch cfg.Gender {
+	case "male":
+		return lang.Ending(r, word, givenEndingsMale)
+	case "female":
+		return lang.Ending(r, word, givenEndingsFemale)
+	}
+	return lang.Ending(r, word, givenEndingsNeutral)
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2)
+	if realism < 40 {
+		n = 1 + r.Intn(3)
+	}
+	word := lang.Word(r, n)
+	if r.Intn(100) < 80 {
+		return lang.Ending(r, word, surnameEndings)
+	}
+	return word
+}
 
 func (p uzbekProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
 
-	realism := cfg.Realism
-	if realism < 0 {
-		realism = 0
+	first, gender := pickGiven(r, cfg, realism, useRealPct)
+	res := api.NameResult{First: first}
+	if cfg.IncludeLast {
+		base := ""
+		if api.Chance(r, useRealPct) {
+			base = api.PickRand(surnames, r)
+		} else {
+			base = api.Title(genSurnameProcedural(r, realism))
+		}
+		form := surnameForm(gender)
+		res.Last = p.InflectSurname(base, form, "")
+		res.Parts = &api.NameParts{SurnameBase: base, SurnameForm: form}
 	}
-	if realism > 100 {
-		realism = 100
+	if api.NativeScript(p, cfg) != "" {
+		res.Native = turkic.UzbekCyrillic(strings.TrimSpace(res.First + " " + res.Last))
 	}
+	return res, nil
+}
 
-	useRealPct := 0
-	switch {
-	case realism >= 95:
-		useRealPct = 95
-	case realism >= 90:
-		useRealPct = 90
-	case realism >= 80:
-		useRealPct = 80
-	case realism >= 70:
-		useRealPct = 55
-	case realism >= 60:
-		useRealPct = 35
-	case realism >= 40:
-		useRealPct = 20
-	default:
-		useRealPct = 5
-	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		n := 2
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		} else if r.Intn(100) < 30 {
-			n = 2 + r.Intn(2)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		switch cfg.Gender {
-		case "male":
-			b.WriteString(api.PickRand(givenEndingsMale, r))
-		case "female":
-			b.WriteString(api.PickRand(givenEndingsFemale, r))
-		default:
-			b.WriteString(api.PickRand(givenEndingsNeutral, r))
-		}
-		return b.String()
+// pickGiven picks a given name and the gender it resolves to: a neutral
+// request that draws a curated man's or woman's name takes that gender,
+// and only procedural neutral names stay "".
+func pickGiven(r api.RandLike, cfg api.ProfileConfig, realism, useRealPct int) (string, string) {
+	if !api.Chance(r, useRealPct) {
+		return api.Title(genGivenProcedural(r, cfg, realism)), cfg.Gender
+	}
+	switch cfg.Gender {
+	case "male":
+		return api.PickRand(givenMale, r), "male"
+	case "female":
+		return api.PickRand(givenFemale, r), "female"
+	}
+	roll := r.Intn(100)
+	if roll < 60 {
+		name := api.PickRand(givenNeutral, r)
+		return name, curatedGender(name)
+	} else if roll < 80 {
+		return api.PickRand(givenMale, r), "male"
 	}
+	return api.PickRand(givenFemale, r), "female"
+}
 
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2)
-		if realism < 40 {
-			n = 1 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 80 {
-			sfx := api.PickRand(surnameEndings, r)
-			if !strings.HasSuffix(b.String(), sfx) {
-				b.WriteString(sfx)
-			}
-		}
-		return b.String()
-	}
-
-	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(givenMale, r)
-		case "female":
-			first = api.PickRand(givenFemale, r)
-		default:
-			roll := r.Intn(100)
-			if roll < 60 {
-				first = api.PickRand(givenNeutral, r)
-			} else if roll < 80 {
-				first = api.PickRand(givenMale, r)
-			} else {
-				first = api.PickRand(givenFemale, r)
-			}
-		}
-	} else {
-		first = caser.String(genGivenProcedural())
+// curatedGender is the gender of a curated given name, or "" when it is in
+// both lists or neither.
+func curatedGender(name string) string {
+	male, female := slices.Contains(givenMale, name), slices.Contains(givenFemale, name)
+	switch {
+	case male && !female:
+		return "male"
+	case female && !male:
+		return "female"
 	}
+	return ""
+}
 
-	last := ""
-	if cfg.IncludeLast {
-		if chooseFromReal() {
-			last = api.PickRand(surnames, r)
-		} else {
-			last = caser.String(genSurnameProcedural())
-		}
+// surnameForm is the surname form for a name's gender; neutral keeps the
+// men's form.
+func surnameForm(gender string) api.SurnameForm {
+	switch gender {
+	case "male":
+		return api.SurnameMasculine
+	case "female":
+		return api.SurnameFeminine
 	}
+	return ""
+}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+// InflectSurname gives a woman's form of a surname: -ova, -yeva, -ina.
+func (p uzbekProfile) InflectSurname(base string, form api.SurnameForm, _ string) string {
+	if form == "" || form == api.SurnameMasculine {
+		return base
+	}
+	return turkic.Feminine(base, feminine)
 }
 
 var Profile uzbekProfile
@@ -0,0 +1,264 @@
+package vietnamese
+
+import (
+	"strings"
+
+	"github.com/nsa-yoda/namegen/api"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Vietnamese orthography (chữ Quốc ngữ): the vowel letters ă â ê ô ơ ư and
+// the consonant đ are letters of their own, and each syllable carries one of
+// six tones, marked on its main vowel. Procedural syllables are spelled with
+// plain letters; spell adds the letters and the tone.
+
+// Tones, in their traditional order. Ngang is unmarked.
+const (
+	ngang = iota // level: a
+	huyen        // falling, grave: à
+	sac          // rising, acute: á
+	hoi          // dipping, hook above: ả
+	nga          // broken, tilde: ã
+	nang         // heavy, dot below: ạ
+)
+
+var toneMarks = [...]string{"", "\u0300", "\u0301", "\u0309", "\u0303", "\u0323"}
+
+const vowelLetters = "aăâeêioôơuưy"
+
+// Letters with a vowel quality mark; the tone goes on them first (iê, ươ, uô).
+const qualityVowels = "ăâêôơư"
+
+// Chances, in percent, that spell gives a vowel its quality mark and an
+// initial d its bar (đ).
+const (
+	qualityPct = 20
+	dBarPct    = 40
+)
+
+// qualities lists the marked letters a plain vowel may become.
+var qualities = map[rune][]string{
+	'a': {"â", "ă"},
+	'e': {"ê"},
+	'o': {"ô", "ơ"},
+	'u': {"ư"},
+}
+
+func isVowel(c rune) bool { return strings.ContainsRune(vowelLetters, c) }
+
+// split cuts a lowercase syllable into onset, vowel nucleus and coda. The u
+// of qu and the i of gi before a vowel belong to the onset.
+func split(syl string) (onset, nucleus, coda []rune) {
+	rs := []rune(syl)
+	i := 0
+	for i < len(rs) && !isVowel(rs[i]) {
+		i++
+	}
+	if i > 0 && i+1 < len(rs) && isVowel(rs[i+1]) &&
+		((rs[i-1] == 'q' && rs[i] == 'u') || (i == 1 && rs[0] == 'g' && rs[i] == 'i')) {
+		i++
+	}
+	j := i
+	for j < len(rs) && isVowel(rs[j]) {
+		j++
+	}
+	return rs[:i], rs[i:j], rs[j:]
+}
+
+// checked reports a syllable ending in a stop, which takes only sắc or nặng.
+func checked(coda string) bool {
+	return coda == "p" || coda == "t" || coda == "c" || coda == "ch"
+}
+
+// toneVowel is the index in nucleus of the vowel that carries the tone:
+// the last vowel with a quality mark, else the last vowel before a coda,
+// else the middle of three vowels, else the first of two, except in oa, oe
+// and uy (hoà, thuý).
+func toneVowel(nucleus, coda []rune) int {
+	for i := len(nucleus) - 1; i >= 0; i-- {
+		if strings.ContainsRune(qualityVowels, nucleus[i]) {
+			return i
+		}
+	}
+	switch {
+	case len(nucleus) == 1:
+		return 0
+	case len(coda) > 0:
+		return len(nucleus) - 1
+	case len(nucleus) >= 3:
+		return 1
+	}
+	switch string(nucleus) {
+	case "oa", "oe", "uy":
+		return 1
+	}
+	return 0
+}
+
+// markTone writes tone on a lowercase syllable.
+func markTone(syl string, tone int) string {
+	onset, nucleus, coda := split(syl)
+	if tone == ngang || len(nucleus) == 0 {
+		return syl
+	}
+	i := toneVowel(nucleus, coda)
+	s := string(onset) + string(nucleus[:i+1]) + toneMarks[tone] + string(nucleus[i+1:]) + string(coda)
+	return norm.NFC.String(s)
+}
+
+// Nuclei ending in a glide, which take no final consonant (cai, sao, kêu).
+var openNuclei = map[string]bool{"ai": true, "ao": true, "au": true, "eo": true, "oi": true}
+
+// rhyme makes a plain nucleus and coda a legal Vietnamese rhyme: only the
+// finals c ch m n ng nh p t, none after a glide (ai, eo), ia and ua closed
+// as iê and uô, oo only before ng and c, a lone y closed as i, and ch and
+// nh only after a, e (as ê), i, oa and uy, which take them for c and ng.
+func rhyme(nucleus, coda string) (string, string) {
+	if coda == "" {
+		switch nucleus {
+		case "ie":
+			nucleus = "ia"
+		case "uo":
+			nucleus = "ua"
+		case "oo":
+			nucleus = "o"
+		}
+		return nucleus, coda
+	}
+	switch {
+	case openNuclei[nucleus]:
+		return nucleus, ""
+	case nucleus == "oo" && coda != "ng" && coda != "c":
+		nucleus = "o"
+	case nucleus == "ia":
+		nucleus = "ie"
+	case nucleus == "ua":
+		nucleus = "uo"
+	case nucleus == "y":
+		nucleus = "i"
+	}
+	switch nucleus {
+	case "i", "uy":
+		switch coda {
+		case "ng":
+			coda = "nh"
+		case "c":
+			coda = "ch"
+		}
+		if nucleus == "uy" && coda != "n" && coda != "nh" && coda != "t" && coda != "ch" {
+			coda = ""
+		}
+	case "a", "e", "oa":
+	default:
+		switch coda {
+		case "nh":
+			coda = "ng"
+		case "ch":
+			coda = "c"
+		}
+	}
+	return nucleus, coda
+}
+
+// onset spells a plain onset before a nucleus: c before a back vowel and k
+// before e, i and y; qu before a, e, i and y (kiên, not quiên; cuông, not
+// quông); and gh and ngh before e and i. It may move the u of qu into the
+// nucleus.
+func onset(o, nucleus string) (string, string) {
+	front := strings.IndexByte("eiy", nucleus[0]) >= 0
+	switch o {
+	case "q", "qu":
+		switch {
+		case strings.IndexByte("aey", nucleus[0]) >= 0, nucleus == "i":
+			return "qu", nucleus
+		case o == "qu" && !front:
+			return "c", "u" + nucleus
+		}
+		return onset("c", nucleus)
+	case "c", "k":
+		if front {
+			return "k", nucleus
+		}
+		return "c", nucleus
+	case "g", "ng":
+		if front {
+			// ghi and nghi, never y after them
+			return o + "h", strings.Replace(nucleus, "y", "i", 1)
+		}
+	}
+	return o, nucleus
+}
+
+// spell turns a plain procedural syllable into Vietnamese spelling: a legal
+// onset and rhyme, an initial đ, vowel quality marks and a tone. A lone
+// vowel may take its mark (ă and â only before a final other than ch and nh,
+// ê always before them and never before c and ng); ie and uo always do
+// before a final (iê, uô, ươ).
+func spell(r api.RandLike, syl string) string {
+	o, n, c := split(strings.ToLower(syl))
+	ons, nucleus := onset(string(o), string(n))
+	var coda string
+	if ons == "qu" && nucleus == "y" {
+		nucleus, coda = rhyme("uy", string(c)) // quynh, like huynh
+		nucleus = "y"
+	} else {
+		nucleus, coda = rhyme(nucleus, string(c))
+	}
+	if ons == "" && nucleus == "ie" {
+		ons, nucleus = "y", "e" // yên, not iên
+	}
+	if ons == "d" && api.Chance(r, dBarPct) {
+		ons = "đ"
+	}
+	var b strings.Builder
+	b.WriteString(ons)
+	front := coda == "nh" || coda == "ch"
+	switch v := nucleus; {
+	case v == "e" && (front || ons == "y"):
+		b.WriteString("ê")
+	case len(v) == 1:
+		q := qualities[rune(v[0])]
+		switch {
+		case v == "a" && (coda == "" || front), v == "e" && (coda == "c" || coda == "ng"):
+			q = nil
+		}
+		if len(q) > 0 && api.Chance(r, qualityPct) {
+			v = api.PickRand(q, r)
+		}
+		b.WriteString(v)
+	case v == "ie" && coda != "":
+		b.WriteString("iê")
+	case v == "uo" && coda != "":
+		b.WriteString(api.PickRand([]string{"uô", "ươ"}, r))
+	default:
+		b.WriteString(v)
+	}
+	b.WriteString(coda)
+
+	tone := r.Intn(6)
+	if checked(coda) {
+		tone = sac
+		if api.Chance(r, 50) {
+			tone = nang
+		}
+	}
+	return markTone(b.String(), tone)
+}
+
+// spellName spells each syllable (word) of a procedural name and title-cases it.
+func spellName(r api.RandLike, name string) string {
+	words := strings.Fields(name)
+	for i, w := range words {
+		words[i] = spell(r, w)
+	}
+	return api.Title(strings.Join(words, " "))
+}
+
+// toneRand is the RNG for spell, derived from the name's seed so that the
+// plain syllables, and their -ascii folding, do not depend on the marks.
+func toneRand(cfg api.ProfileConfig) api.RandLike {
+	if cfg.Seed != 0 {
+		cfg.Seed = api.DeriveSeed(cfg.Seed, "vietnamese", "tones")
+	}
+	return api.NewRand(cfg)
+}
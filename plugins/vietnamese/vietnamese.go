@@ -1,11 +1,10 @@
 package vietnamese
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type vietnameseProfile struct{}
@@ -19,40 +18,97 @@ func init() {
 func (p vietnameseProfile) Info() map[string]string {
 	return map[string]string{
 		"name":  PROFILE,
-		"notes": "Vietnamese names (ASCII romanization): realism blends curated lists with procedural syllables; deterministic with seed",
+		"notes": "Vietnamese names, family + middle + given with tone marks (Nguyễn Thị Thu Hương); -ascii folds them; realism blends curated lists with procedural syllables",
 	}
 }
 
-// Note: Vietnamese naming convention is typically Family (surname) + Middle + Given.
-// This generator returns First + Last; here we treat \"First\" as given name and \"Last\" as surname,
-// with an optional middle-like component folded into First at lower realism.
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p vietnameseProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:    givenMale,
+		FirstFemale:  givenFemale,
+		FirstNeutral: givenNeutral,
+		Last:         surnames,
+		NeutralMixes: true,
+		Onsets:       onsets,
+		Nuclei:       vowels,
+		Codas:        codas,
+		GivenEndings: givenEndings,
+	}
+}
+
+// Forms gives the kinship titles (Ông, Bà, Anh, Chị), used with the given
+// name.
+func (p vietnameseProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Ông", Gender: "male", Weight: 40, Use: api.UseGiven},
+			{Text: "Bà", Gender: "female", Weight: 40, Use: api.UseGiven},
+			{Text: "Anh", Gender: "male", Weight: 20, Use: api.UseGiven},
+			{Text: "Chị", Gender: "female", Weight: 20, Use: api.UseGiven},
+			{Text: "Cô", Gender: "female", Weight: 10, Use: api.UseGiven},
+			{Text: "Thầy", Gender: "male", Weight: 4, Use: api.UseGiven},
+		},
+	}
+}
+
+// NicknameRules puts Bé before the name a person is called by (the last
+// given name); there are no curated nicknames.
+func (p vietnameseProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Prefixes: []string{"Bé"},
+		NoClip:   true,
+	}
+}
+
+// Vietnamese names are Family + Middle + Given ("Nguyễn Thị Thu Hương"),
+// written family first. First is the given name, which the name is used by
+// (Chị Hương); Parts carries the middle name and the full name in order.
 
 var givenMale = []string{
-	"Anh", "Bao", "Binh", "Cuong", "Duc", "Hieu", "Hoang", "Hung", "Khanh", "Khoa",
-	"Long", "Minh", "Nam", "Phuc", "Quan", "Son", "Tuan", "Viet", "Thanh", "Thien",
-	"Dat", "Kiet", "Lam", "Luan", "Nghia", "Phu", "Tai", "Trung", "Vu", "Xuan",
+	"Anh", "Bảo", "Bình", "Cường", "Đức", "Hiếu", "Hoàng", "Hùng", "Khánh", "Khoa",
+	"Long", "Minh", "Nam", "Phúc", "Quân", "Sơn", "Tuấn", "Việt", "Thành", "Thiện",
+	"Đạt", "Kiệt", "Lâm", "Luân", "Nghĩa", "Phú", "Tài", "Trung", "Vũ", "Xuân",
 }
 
 var givenFemale = []string{
-	"An", "Chi", "Diem", "Dung", "Giang", "Han", "Hanh", "Hoa", "Huong", "Lan",
-	"Linh", "Mai", "My", "Nga", "Ngoc", "Nhi", "Phuong", "Quynh", "Thao", "Trang",
-	"Thuy", "Tien", "Trinh", "Tuyet", "Vy", "Yen", "Ha", "Hien", "Kim", "Thao",
+	"An", "Chi", "Diễm", "Dung", "Giang", "Hân", "Hạnh", "Hoa", "Hương", "Lan",
+	"Linh", "Mai", "My", "Nga", "Ngọc", "Nhi", "Phương", "Quỳnh", "Thảo", "Trang",
+	"Thủy", "Tiên", "Trinh", "Tuyết", "Vy", "Yến", "Hà", "Hiền", "Kim", "Thu",
 }
 
 var givenNeutral = []string{
-	"Anh", "Khanh", "Linh", "Minh", "An", "Chi", "Giang", "Ha", "My", "Vy",
+	"Anh", "Khánh", "Linh", "Minh", "An", "Chi", "Giang", "Hà", "My", "Vy",
 }
 
-// Very common Vietnamese surnames (ASCII).
+// Leading syllables of two-syllable given names ("Thu Hương", "Quốc Bảo").
+var (
+	leadsMale    = []string{"Minh", "Quốc", "Gia", "Bảo", "Đức", "Thanh", "Hoàng", "Tuấn"}
+	leadsFemale  = []string{"Thu", "Ngọc", "Kim", "Bảo", "Thanh", "Diệu", "Mỹ", "Phương"}
+	leadsNeutral = []string{"Minh", "Gia", "Bảo", "Thanh", "Khánh", "An"}
+)
+
+// Very common Vietnamese surnames.
 var surnames = []string{
-	"Nguyen", "Tran", "Le", "Pham", "Huynh", "Hoang", "Phan", "Vu", "Vo", "Dang",
-	"Bui", "Do", "Ho", "Ngo", "Duong", "Ly", "Dinh", "Truong", "Ha", "Dao",
+	"Nguyễn", "Trần", "Lê", "Phạm", "Huỳnh", "Hoàng", "Phan", "Vũ", "Võ", "Đặng",
+	"Bùi", "Đỗ", "Hồ", "Ngô", "Dương", "Lý", "Đinh", "Trương", "Hà", "Đào",
 }
 
-// Common middle names (often gendered, but used flexibly here).
-var middles = []string{
-	"Van", "Thi", "Huu", "Gia", "Quoc", "Duc", "Minh", "Ngoc", "Thanh", "Thuy",
-}
+// Middle names (tên đệm). Văn and Thị are the classic men's and women's
+// middles; at high realism the middle agrees with gender.
+var (
+	middlesMale    = []string{"Văn", "Văn", "Hữu", "Đức", "Quốc", "Công", "Quang", "Minh", "Thành", "Gia"}
+	middlesFemale  = []string{"Thị", "Thị", "Ngọc", "Thu", "Kim", "Mỹ", "Diệu", "Bích", "Thanh", "Phương"}
+	middlesNeutral = []string{"Minh", "Thanh", "Gia", "Bảo", "Hoài", "Khánh", "Nhật"}
+)
+
+// Chances, in percent, of a two-syllable given name, and of a middle name
+// below agreeingRealism, where any middle may be drawn.
+const (
+	leadPct         = 20
+	middlePct       = 80
+	agreeingRealism = 70
+)
 
 var onsets = []string{
 	"", "",
@@ -60,120 +116,138 @@ var onsets = []string{
 	"ch", "ng", "nh", "ph", "th", "tr",
 }
 var vowels = []string{
-	"a", "e", "i", "o", "u", "y", "ai", "ao", "au", "ea", "eo", "ia", "ie", "oa", "oi", "oo", "ua", "uo",
+	"a", "e", "i", "o", "u", "y", "ai", "ao", "au", "uy", "eo", "ia", "ie", "oa", "oi", "oo", "ua", "uo",
 }
 var codas = []string{
 	"", "", "", "", // many Vietnamese syllables are open in romanized text
 	"n", "m", "ng", "nh", "t", "c", "p",
 }
 
-var givenEndings = []string{"", "", "", "h", "n", "t", "ng"}
+var givenEndings = []string{"", "", "", "n", "t", "ng"}
 
-func (p vietnameseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
-	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
+func genSyl(r api.RandLike) string {
+	// Keep it compact: onset + vowel + optional coda; endings close open syllables.
+	s := api.PickRand(onsets, r) + api.PickRand(vowels, r)
+	coda := api.PickRand(codas, r)
+	if coda == "" && r.Intn(100) < 25 {
+		coda = api.PickRand(givenEndings, r)
+	}
+	return s + coda
+}
 
-	realism := cfg.Realism
-	if realism < 0 {
-		realism = 0
+// genWord makes a procedural name of n syllables, each its own word.
+func genWord(r api.RandLike, n int) string {
+	syls := make([]string, n)
+	for i := range syls {
+		syls[i] = genSyl(r)
 	}
-	if realism > 100 {
-		realism = 100
+	return strings.Join(syls, " ")
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// Vietnamese given names are often 1 syllable; sometimes 2.
+	n := 1
+	if realism < 40 && r.Intn(100) < 40 {
+		n = 2
+	} else if r.Intn(100) < 20 {
+		n = 2
 	}
+	return genWord(r, n)
+}
 
-	// same curve as your other profiles
-	useRealPct := 0
-	switch {
-	case realism >= 95:
-		useRealPct = 95
-	case realism >= 90:
-		useRealPct = 90
-	case realism >= 80:
-		useRealPct = 80
-	case realism >= 70:
-		useRealPct = 55
-	case realism >= 60:
-		useRealPct = 35
-	case realism >= 40:
-		useRealPct = 20
+// pickGiven picks a curated given name, sometimes of two syllables.
+func pickGiven(r api.RandLike, gender string) string {
+	given, leads := givenNeutral, leadsNeutral
+	switch gender {
+	case "male":
+		given, leads = givenMale, leadsMale
+	case "female":
+		given, leads = givenFemale, leadsFemale
 	default:
-		useRealPct = 5
+		roll := r.Intn(100)
+		if roll >= 80 {
+			given = givenFemale
+		} else if roll >= 60 {
+			given = givenMale
+		}
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		// Keep it compact: onset + vowel + optional coda.
-		s := api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-		if r.Intn(100) < 25 {
-			s += api.PickRand(givenEndings, r)
+	name := api.PickRand(given, r)
+	if api.Chance(r, leadPct) {
+		if lead := api.PickRand(leads, r); lead != name {
+			name = lead + " " + name
 		}
-		return s
 	}
+	return name
+}
 
-	genGivenProcedural := func() string {
-		// Vietnamese given names are often 1 syllable; sometimes 2.
-		n := 1
-		if realism < 40 && r.Intn(100) < 40 {
-			n = 2
-		} else if r.Intn(100) < 20 {
-			n = 2
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		return b.String()
+// pickMiddle picks the middle name: one agreeing with gender at high
+// realism, otherwise usually one from any list. It avoids repeating the
+// given name's first syllable ("Minh Minh").
+func pickMiddle(r api.RandLike, gender, first string, realism int) string {
+	var list []string
+	switch {
+	case realism >= agreeingRealism && gender == "male":
+		list = middlesMale
+	case realism >= agreeingRealism && gender == "female":
+		list = middlesFemale
+	case realism >= agreeingRealism:
+		list = middlesNeutral
+	case api.Chance(r, middlePct):
+		list = slices.Concat(middlesMale, middlesFemale, middlesNeutral)
+	default:
+		return ""
+	}
+	head, _, _ := strings.Cut(first, " ")
+	middle := api.PickRand(list, r)
+	if api.FoldASCII(middle) == api.FoldASCII(head) {
+		middle = api.PickRand(list, r)
 	}
+	if api.FoldASCII(middle) == api.FoldASCII(head) {
+		return ""
+	}
+	return middle
+}
+
+func (p vietnameseProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
+	r := api.NewRand(cfg)
+	tones := toneRand(cfg)
+	realism := min(max(cfg.Realism, 0), 100)
+	useRealPct := api.UseRealPct(realism)
 
 	// ---- Given name (First) ----
 	first := ""
-	if chooseFromReal() {
-		switch cfg.Gender {
-		case "male":
-			first = api.PickRand(givenMale, r)
-		case "female":
-			first = api.PickRand(givenFemale, r)
-		default:
-			roll := r.Intn(100)
-			if roll < 60 {
-				first = api.PickRand(givenNeutral, r)
-			} else if roll < 80 {
-				first = api.PickRand(givenMale, r)
-			} else {
-				first = api.PickRand(givenFemale, r)
-			}
-		}
+	if api.Chance(r, useRealPct) {
+		first = pickGiven(r, cfg.Gender)
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = spellName(tones, genGivenProcedural(r, realism))
 	}
-
-	// Optionally add a middle name at mid/low realism (or when realism is high but random says so).
-	// We fold it into First as "First Middle" so output remains First/Last.
-	if r.Intn(100) < 35 && realism < 85 {
-		mid := api.PickRand(middles, r)
-		first = caser.String(first) + " " + caser.String(mid)
+	middle := pickMiddle(r, cfg.Gender, first, realism)
+	if !cfg.IncludeLast {
+		// The middle name is kept for callers that add the family name
+		// themselves (family trees).
+		res := api.NameResult{First: first}
+		if middle != "" {
+			res.Parts = &api.NameParts{Middle: middle, GivenNames: strings.Fields(first)}
+		}
+		return res, nil
 	}
 
 	// ---- Surname (Last) ----
 	last := ""
-	if cfg.IncludeLast {
-		if chooseFromReal() {
-			last = api.PickRand(surnames, r)
-		} else {
-			// Surname-like: usually 1 syllable but can be 2 in procedural mode
-			n := 1
-			if r.Intn(100) < 25 {
-				n = 2
-			}
-			var b strings.Builder
-			for i := 0; i < n; i++ {
-				b.WriteString(genSyl())
-			}
-			last = caser.String(b.String())
+	if api.Chance(r, useRealPct) {
+		last = api.PickRand(surnames, r)
+	} else {
+		// Surname-like: one syllable, sometimes a compound of two (Tôn Thất).
+		n := 1
+		if r.Intn(100) < 10 {
+			n = 2
 		}
+		last = spellName(tones, genWord(r, n))
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	parts := &api.NameParts{Middle: middle, GivenNames: strings.Fields(first)}
+	parts.Full = strings.Join(slices.DeleteFunc([]string{last, middle, first}, func(s string) bool { return s == "" }), " ")
+	return api.NameResult{First: first, Last: last, Parts: parts}, nil
 }
 
 var Profile vietnameseProfile
@@ -0,0 +1,104 @@
+package vietnamese_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nsa-yoda/namegen/api"
+	"github.com/nsa-yoda/namegen/plugins/vietnamese"
+	"golang.org/x/text/unicode/norm"
+)
+
+// TestProceduralSpelling spells procedural names for many seeds and checks
+// every syllable against the rules of Vietnamese spelling.
+func TestProceduralSpelling(t *testing.T) {
+	for _, gender := range []string{"male", "female", "neutral"} {
+		for seed := int64(1); seed <= 2000; seed++ {
+			cfg := api.ProfileConfig{Seed: seed, Realism: 0, Gender: gender, IncludeLast: true}
+			res, err := api.Generate(vietnamese.Profile, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, syl := range strings.Fields(res.Last + " " + res.First) {
+				if err := validSyllable(syl); err != nil {
+					t.Errorf("seed %d %s: %q in %q: %v", seed, gender, syl, res.Last+" "+res.First, err)
+				}
+			}
+		}
+	}
+}
+
+// Onsets, longest first, and the finals a syllable may end in.
+var (
+	onsets = []string{"ngh", "gh", "gi", "kh", "ng", "nh", "ph", "qu", "th", "tr", "ch",
+		"b", "c", "d", "đ", "g", "h", "k", "l", "m", "n", "p", "r", "s", "t", "v", "x"}
+	finals = map[string]bool{"": true, "c": true, "ch": true, "m": true, "n": true, "ng": true, "nh": true, "p": true, "t": true}
+)
+
+// toneless removes the six tone marks but keeps the letters ă â ê ô ơ ư.
+func toneless(s string) string {
+	return norm.NFC.String(strings.Map(func(r rune) rune {
+		switch r {
+		case '̀', '́', '̃', '̉', '̣':
+			return -1
+		}
+		return r
+	}, norm.NFD.String(s)))
+}
+
+func validSyllable(syl string) error {
+	s := strings.ToLower(toneless(syl))
+	onset := ""
+	for _, o := range onsets {
+		if strings.HasPrefix(s, o) && len(s) > len(o) && strings.ContainsRune("aăâeêioôơuưy", []rune(s[len(o):])[0]) {
+			onset = o
+			break
+		}
+	}
+	rest := []rune(s[len(onset):])
+	i := 0
+	for i < len(rest) && strings.ContainsRune("aăâeêioôơuưy", rest[i]) {
+		i++
+	}
+	nucleus, final := string(rest[:i]), string(rest[i:])
+	if nucleus == "" {
+		return fmt.Errorf("no vowel after %q", onset)
+	}
+	first := []rune(nucleus)[0]
+	front := strings.ContainsRune("eêiy", first)
+	last := []rune(nucleus)[len([]rune(nucleus))-1]
+	if onset == "qu" && nucleus == "y" {
+		nucleus = "uy" // quynh rhymes as huynh
+	}
+
+	switch {
+	case onset == "c" && front:
+		return fmt.Errorf("c before %c, want k", first)
+	case onset == "k" && !front:
+		return fmt.Errorf("k before %c, want c", first)
+	case (onset == "g" || onset == "ng") && strings.ContainsRune("eêi", first):
+		return fmt.Errorf("%s before %c, want %sh", onset, first, onset)
+	case (onset == "g" || onset == "gh" || onset == "ng" || onset == "ngh") && first == 'y':
+		return fmt.Errorf("y after %s, want i", onset)
+	case (onset == "gh" || onset == "ngh") && !strings.ContainsRune("eêi", first):
+		return fmt.Errorf("%s before %c", onset, first)
+	case onset == "qu" && strings.ContainsRune("ouư", first):
+		return fmt.Errorf("qu before %c", first)
+	case !finals[final]:
+		return fmt.Errorf("final %q", final)
+	case (final == "ch" || final == "nh") && !strings.ContainsRune("aêiy", last):
+		return fmt.Errorf("%s after %c", final, last)
+	case final != "" && len([]rune(nucleus)) > 1 && strings.ContainsRune("iouy", last) && nucleus != "uy" && nucleus != "oo":
+		return fmt.Errorf("final %q after the glide of %q", final, nucleus)
+	case final != "" && (nucleus == "ia" || nucleus == "ua" || nucleus == "ưa" || nucleus == "y"):
+		return fmt.Errorf("final %q after %q", final, nucleus)
+	case final == "" && (strings.HasSuffix(nucleus, "iê") || strings.HasSuffix(nucleus, "yê") || strings.HasSuffix(nucleus, "uô") || strings.HasSuffix(nucleus, "ươ")):
+		return fmt.Errorf("%q without a final", nucleus)
+	case nucleus == "oo" && final != "ng" && final != "c":
+		return fmt.Errorf("oo before %q", final)
+	case onset == "" && strings.HasPrefix(nucleus, "iê"):
+		return fmt.Errorf("bare iê, want yê")
+	}
+	return nil
+}
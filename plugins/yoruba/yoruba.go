@@ -4,8 +4,6 @@ import (
 	"strings"
 
 	"github.com/nsa-yoda/namegen/api"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type yorubaProfile struct{}
@@ -23,6 +21,47 @@ func (p yorubaProfile) Info() map[string]string {
 	}
 }
 
+// Inventory exposes the curated lists and syllable parts for api.EstimateSpace.
+func (p yorubaProfile) Inventory() api.Inventory {
+	return api.Inventory{
+		FirstMale:      givenMale,
+		FirstFemale:    givenFemale,
+		FirstNeutral:   givenNeutral,
+		Last:           surnames,
+		NeutralMixes:   true,
+		Onsets:         onsets,
+		Nuclei:         vowels,
+		Codas:          codas,
+		GivenEndings:   givenEndings,
+		SurnameEndings: surnameEndings,
+	}
+}
+
+// Forms gives Arakunrin and Arabinrin, Alhaji and Alhaja with the given
+// name, and Chief and Oloye before the full name.
+func (p yorubaProfile) Forms() api.Forms {
+	return api.Forms{
+		Titles: []api.Honorific{
+			{Text: "Chief", Weight: 15, Use: api.UseFull},
+			{Text: "Alhaji", Gender: "male", Weight: 10, Use: api.UseGiven},
+			{Text: "Alhaja", Gender: "female", Weight: 10, Use: api.UseGiven},
+			{Text: "Arakunrin", Gender: "male", Weight: 20},
+			{Text: "Arabinrin", Gender: "female", Weight: 20},
+			{Text: "Oloye", Weight: 5, Use: api.UseFull},
+			{Text: "Dr.", Weight: 4},
+		},
+	}
+}
+
+// NicknameRules gives curated short forms (Oluwaseun -> Seun) and
+// open-syllable stems of other names.
+func (p yorubaProfile) NicknameRules() api.NicknameRules {
+	return api.NicknameRules{
+		Curated:  nicknames,
+		OpenStem: true,
+	}
+}
+
 // Yoruba names often have meaningful compounds. Romanization varies; we keep ASCII.
 var givenMale = []string{
 	"Oladele", "Oluwaseun", "Oluwatobi", "Olamide", "Olawale", "Adewale", "Adekunle", "Adebayo", "Adeyemi", "Babajide",
@@ -64,9 +103,65 @@ var codas = []string{
 var givenEndings = []string{"", "", "", "de", "mi", "se", "to", "bo", "ye", "ni"}
 var surnameEndings = []string{"", "", "", "yemi", "bayo", "wale", "tunde", "kunle", "tobi"}
 
+// nicknames maps curated given names (lowercase) to their usual nicknames.
+var nicknames = map[string][]string{
+	"oluwaseun":     {"Seun"},
+	"oluwafemi":     {"Femi"},
+	"oluwatobi":     {"Tobi"},
+	"adebayo":       {"Bayo"},
+	"olumide":       {"Mide"},
+	"ayodele":       {"Ayo", "Dele"},
+	"babatunde":     {"Tunde"},
+	"olufunmilayo":  {"Funmi"},
+	"oluwakemi":     {"Kemi"},
+	"folasade":      {"Sade", "Fola"},
+	"temitope":      {"Tope", "Temi"},
+	"oluwadamilare": {"Dami"},
+	"abimbola":      {"Bimbo"},
+	"adewale":       {"Wale"},
+	"olusegun":      {"Segun"},
+	"adetokunbo":    {"Tokunbo"},
+}
+
+func genSyl(r api.RandLike) string {
+	return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
+}
+
+func genGivenProcedural(r api.RandLike, realism int) string {
+	// Yoruba names can be 3-4 syllables; bias slightly longer.
+	n := 3
+	if realism < 40 {
+		n = 2 + r.Intn(3) // 2..4
+	} else if r.Intn(100) < 25 {
+		n = 2 + r.Intn(3)
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 50 {
+		b.WriteString(api.PickRand(givenEndings, r))
+	}
+	return b.String()
+}
+
+func genSurnameProcedural(r api.RandLike, realism int) string {
+	n := 2 + r.Intn(2) // 2..3
+	if realism < 40 {
+		n = 2 + r.Intn(3) // 2..4
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(genSyl(r))
+	}
+	if r.Intn(100) < 55 {
+		b.WriteString(api.PickRand(surnameEndings, r))
+	}
+	return b.String()
+}
+
 func (p yorubaProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	r := api.NewRand(cfg)
-	caser := cases.Title(language.Und)
 
 	realism := cfg.Realism
 	if realism < 0 {
@@ -93,47 +188,9 @@ func (p yorubaProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 	default:
 		useRealPct = 5
 	}
-	chooseFromReal := func() bool { return r.Intn(100) < useRealPct }
-
-	genSyl := func() string {
-		return api.PickRand(onsets, r) + api.PickRand(vowels, r) + api.PickRand(codas, r)
-	}
-
-	genGivenProcedural := func() string {
-		// Yoruba names can be 3-4 syllables; bias slightly longer.
-		n := 3
-		if realism < 40 {
-			n = 2 + r.Intn(3) // 2..4
-		} else if r.Intn(100) < 25 {
-			n = 2 + r.Intn(3)
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 50 {
-			b.WriteString(api.PickRand(givenEndings, r))
-		}
-		return b.String()
-	}
-
-	genSurnameProcedural := func() string {
-		n := 2 + r.Intn(2) // 2..3
-		if realism < 40 {
-			n = 2 + r.Intn(3) // 2..4
-		}
-		var b strings.Builder
-		for i := 0; i < n; i++ {
-			b.WriteString(genSyl())
-		}
-		if r.Intn(100) < 55 {
-			b.WriteString(api.PickRand(surnameEndings, r))
-		}
-		return b.String()
-	}
 
 	first := ""
-	if chooseFromReal() {
+	if api.Chance(r, useRealPct) {
 		switch cfg.Gender {
 		case "male":
 			first = api.PickRand(givenMale, r)
@@ -150,19 +207,19 @@ func (p yorubaProfile) Generate(cfg api.ProfileConfig) (api.NameResult, error) {
 			}
 		}
 	} else {
-		first = caser.String(genGivenProcedural())
+		first = api.Title(genGivenProcedural(r, realism))
 	}
 
 	last := ""
 	if cfg.IncludeLast {
-		if chooseFromReal() {
+		if api.Chance(r, useRealPct) {
 			last = api.PickRand(surnames, r)
 		} else {
-			last = caser.String(genSurnameProcedural())
+			last = api.Title(genSurnameProcedural(r, realism))
 		}
 	}
 
-	return api.NameResult{First: caser.String(first), Last: caser.String(last)}, nil
+	return api.NameResult{First: api.Title(first), Last: api.Title(last)}, nil
 }
 
 var Profile yorubaProfile